@@ -0,0 +1,32 @@
+Feature: does not auto-push new branches that a branch type rule makes prototype branches
+
+  Background:
+    Given Git Town setting "push-new-branches" is "true"
+    And local Git Town setting "branch-type-rules" is "prototype=spike/*"
+    And the current branch is "main"
+    When I run "git-town append spike/cache"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                     |
+      | main   | git fetch --prune --tags    |
+      |        | git rebase origin/main      |
+      |        | git branch spike/cache main |
+      |        | git checkout spike/cache    |
+    And the current branch is now "spike/cache"
+    And the branches are now
+      | REPOSITORY | BRANCHES          |
+      | local      | main, spike/cache |
+      | origin     | main              |
+    And this lineage exists now
+      | BRANCH      | PARENT |
+      | spike/cache | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH      | COMMAND                   |
+      | spike/cache | git checkout main         |
+      | main        | git branch -D spike/cache |
+    And the current branch is now "main"
+    And the initial branches and lineage exist
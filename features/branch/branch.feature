@@ -0,0 +1,62 @@
+Feature: display the branch tree
+
+  Scenario: branches of all types
+    Given the current branch is a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a parked branch "parked"
+    And a contribution branch "contribution"
+    And an observed branch "observed"
+    And a perennial branch "qa"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE             |
+      | main   | local, origin | main commit         |
+      | alpha  | local         | local alpha commit  |
+      | alpha  | origin        | origin alpha commit |
+      | beta   | local, origin | beta commit         |
+    When I run "git-town branch"
+    Then it prints:
+      """
+      main  (main branch, up to date)
+        * alpha  (not in sync, 1 ahead of origin/alpha, 1 ahead, 1 behind main)
+            beta  (up to date, 1 ahead, 1 behind alpha)
+          parked  (parked branch, up to date, 1 behind main)
+        contribution  (contribution branch, local only)
+        observed  (observed branch, up to date)
+        qa  (perennial branch, up to date)
+      """
+
+  Scenario: branch without tracking branch
+    Given the current branch is a local feature branch "local"
+    When I run "git-town branch"
+    Then it prints:
+      """
+      main  (main branch, up to date)
+        * local  (local only)
+      """
+
+  @skipWindows
+  Scenario: branch with a proposal
+    Given the current branch is a feature branch "feature"
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 12, "Target": "main", "Title": "feature", "Draft": true}}
+      """
+    When I run "git-town branch"
+    Then it prints:
+      """
+      * feature  (up to date, proposal #12 draft)
+      """
+
+  @skipWindows
+  Scenario: offline
+    Given offline mode is enabled
+    And the current branch is a feature branch "feature"
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 12, "Target": "main", "Title": "feature"}}
+      """
+    When I run "git-town branch"
+    Then it prints:
+      """
+      * feature  (up to date)
+      """
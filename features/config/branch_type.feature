@@ -0,0 +1,93 @@
+Feature: display the branch type of a branch
+
+  Background:
+    Given the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[branches.rules]]
+      branches = "dependabot/*"
+      type = "observed"
+
+      [[branches.rules]]
+      regex = "^release-\\d+$"
+      type = "perennial"
+      """
+
+  Scenario: branch matching a glob pattern rule
+    When I run "git-town config branch-type dependabot/npm"
+    Then it prints:
+      """
+      Branch "dependabot/npm":
+        type: observed branch
+        reason: matches branch type rule 1: observed=dependabot/*
+      """
+
+  Scenario: branch matching a regex rule
+    When I run "git-town config branch-type release-2"
+    Then it prints:
+      """
+      Branch "release-2":
+        type: perennial branch
+        reason: matches branch type rule 2: perennial=/^release-\d+$/
+      """
+
+  Scenario: branch listed for a branch type
+    Given local Git Town setting "parked-branches" is "dependabot/legacy"
+    When I run "git-town config branch-type dependabot/legacy"
+    Then it prints:
+      """
+      Branch "dependabot/legacy":
+        type: parked branch
+        reason: listed in the parked branches
+      """
+
+  Scenario: listed branch matching the perennial regex
+    Given local Git Town setting "observed-branches" is "release-old"
+    And local Git Town setting "perennial-regex" is "^release-"
+    When I run "git-town config branch-type release-old"
+    Then it prints:
+      """
+      Branch "release-old":
+        type: perennial branch
+        reason: matches the perennial regex
+      """
+
+  Scenario: branch matching both the perennial regex and a rule
+    Given local Git Town setting "perennial-regex" is "^dependabot/"
+    When I run "git-town config branch-type dependabot/npm"
+    Then it prints:
+      """
+      Branch "dependabot/npm":
+        type: perennial branch
+        reason: matches the perennial regex
+      """
+
+  Scenario: current branch without matching rule
+    Given the current branch is a feature branch "feature"
+    When I run "git-town config branch-type"
+    Then it prints:
+      """
+      Branch "feature":
+        type: feature branch
+        reason: no branch type setting or rule applies to this branch
+      """
+
+  Scenario: rules in the Git metadata
+    Given local Git Town setting "branch-type-rules" is "contribution=coworker/*"
+    When I run "git-town config branch-type coworker/feature"
+    Then it prints:
+      """
+      Branch "coworker/feature":
+        type: contribution branch
+        reason: matches branch type rule 1: contribution=coworker/*
+      """
+
+  Scenario: invalid rule in the Git metadata
+    Given local Git Town setting "branch-type-rules" is "zonk=coworker/*"
+    When I run "git-town config branch-type coworker/feature"
+    Then it prints the error:
+      """
+      unknown branch type: "zonk"
+      """
@@ -0,0 +1,25 @@
+Feature: ignore invalid branch type regexes
+
+  Background:
+    Given local Git Town setting "perennial-regex" is "release-("
+
+  Scenario: show the configuration
+    When I run "git-town config"
+    Then it prints:
+      """
+      perennial regex: release-( (ignored because it is invalid)
+      """
+
+  Scenario: run a command
+    When I run "git-town hack new"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+      |        | git rebase origin/main   |
+      |        | git branch new main      |
+      |        | git checkout new         |
+    And it prints:
+      """
+      Ignoring invalid perennial regex "release-("
+      """
+    And the current branch is now "new"
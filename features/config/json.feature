@@ -0,0 +1,94 @@
+Feature: show the configuration in JSON
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a perennial branch "qa"
+    And local Git Town setting "sync-upstream" is "false"
+    And global Git Town setting "offline" is "true"
+    And local Git Town setting "github-token" is "secret-token"
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync-strategy]
+      feature-branches = "rebase"
+      """
+    When I run "git-town config --json"
+
+  Scenario: branches
+    Then it prints:
+      """
+        "branches": [
+          {
+            "name": "main",
+      """
+    And it prints something like:
+      """
+      "name": "alpha",
+            "parent": "main",
+            "localSHA": "[0-9a-f]+",
+            "remoteName": "origin/alpha",
+            "remoteSHA": "[0-9a-f]+",
+            "syncStatus": "up to date",
+            "syncStrategy": "rebase",
+            "type": "feature"
+          },
+          {
+            "name": "beta",
+            "parent": "alpha",
+      """
+    And it prints:
+      """
+      "name": "qa",
+      """
+    And it prints:
+      """
+      "type": "perennial"
+      """
+
+  Scenario: settings
+    Then it prints:
+      """
+      "git-town.offline": {
+            "source": "global",
+            "value": true
+          },
+      """
+    And it prints:
+      """
+      "git-town.sync-feature-strategy": {
+            "source": "config-file",
+            "value": "rebase"
+          },
+      """
+    And it prints:
+      """
+      "git-town.sync-perennial-strategy": {
+            "source": "default",
+            "value": "rebase"
+          },
+      """
+    And it prints:
+      """
+      "git-town.sync-upstream": {
+            "source": "local",
+            "value": false
+          },
+      """
+    And it prints:
+      """
+      "git-town.github-token": {
+            "source": "local",
+            "value": "(hidden)"
+          },
+      """
+    And it does not print "secret-token"
+
+  Scenario: subcommand without JSON support
+    When I run "git-town config branch-type --json"
+    Then it prints the error:
+      """
+      unknown flag: --json
+      """
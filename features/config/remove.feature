@@ -4,6 +4,7 @@ Feature: reset the configuration
     Given the main branch is "main"
     And the current branch is a feature branch "feature"
     And the perennial branches are "qa" and "staging"
+    And Git Town sync-strategy setting for branch "feature" is "rebase"
     And global Git setting "alias.hack" is "town hack"
     And global Git setting "alias.sync" is "town sync"
     And global Git setting "alias.append" is "commit --amend"
@@ -0,0 +1,63 @@
+Feature: enter the Bitbucket API credentials
+
+  Scenario: auto-detected Bitbucket platform
+    Given my repo's "origin" remote is "git@bitbucket.org:git-town/git-town.git"
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                        | KEYS              | DESCRIPTION                                 |
+      | welcome                       | enter             |                                             |
+      | aliases                       | enter             |                                             |
+      | main development branch       | enter             |                                             |
+      | perennial branches            |                   | no input here since the dialog doesn't show |
+      | perennial regex               | enter             |                                             |
+      | hosting platform: auto-detect | enter             |                                             |
+      | bitbucket username            | c o d e enter     |                                             |
+      | bitbucket app password        | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname               | enter             |                                             |
+      | sync-feature-strategy         | enter             |                                             |
+      | sync-perennial-strategy       | enter             |                                             |
+      | sync-upstream                 | enter             |                                             |
+      | push-new-branches             | enter             |                                             |
+      | push-hook                     | enter             |                                             |
+      | ship-delete-tracking-branch   | enter             |                                             |
+      | sync-before-ship              | enter             |                                             |
+      | save config to Git metadata   | down enter        |                                             |
+    Then it runs the commands
+      | COMMAND                                           |
+      | git config git-town.bitbucket-username code       |
+      | git config git-town.bitbucket-app-password 123456 |
+    And local Git Town setting "hosting-platform" still doesn't exist
+    And local Git Town setting "bitbucket-username" is now "code"
+    And local Git Town setting "bitbucket-app-password" is now "123456"
+
+  Scenario: select Bitbucket manually
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                      | KEYS              | DESCRIPTION                                 |
+      | welcome                     | enter             |                                             |
+      | aliases                     | enter             |                                             |
+      | main development branch     | enter             |                                             |
+      | perennial branches          |                   | no input here since the dialog doesn't show |
+      | perennial regex             | enter             |                                             |
+      | hosting platform            | down down enter   |                                             |
+      | bitbucket username          | enter             | access tokens don't need a username         |
+      | bitbucket app password      | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname             | enter             |                                             |
+      | sync-feature-strategy       | enter             |                                             |
+      | sync-perennial-strategy     | enter             |                                             |
+      | sync-upstream               | enter             |                                             |
+      | push-new-branches           | enter             |                                             |
+      | push-hook                   | enter             |                                             |
+      | ship-delete-tracking-branch | enter             |                                             |
+      | sync-before-ship            | enter             |                                             |
+      | save config to Git metadata | down enter        |                                             |
+    Then it runs the commands
+      | COMMAND                                           |
+      | git config git-town.bitbucket-app-password 123456 |
+      | git config git-town.hosting-platform bitbucket    |
+    And local Git Town setting "hosting-platform" is now "bitbucket"
+    And local Git Town setting "bitbucket-username" still doesn't exist
+    And local Git Town setting "bitbucket-app-password" is now "123456"
+
+  Scenario: undo
+    When I run "git-town undo"
+    And local Git Town setting "hosting-platform" now doesn't exist
+    And local Git Town setting "bitbucket-app-password" now doesn't exist
@@ -0,0 +1,24 @@
+Feature: select the "compress" sync-feature strategy
+
+  Background:
+    Given Git Town is not configured
+    When I run "git-town config setup" and enter into the dialogs:
+      | DIALOG                      | KEYS            |
+      | welcome                     | enter           |
+      | aliases                     | enter           |
+      | main development branch     | enter           |
+      | perennial branches          | enter           |
+      | perennial regex             | enter           |
+      | hosting platform            | enter           |
+      | origin hostname             | enter           |
+      | sync-feature-strategy       | down down enter |
+      | sync-perennial-strategy     | enter           |
+      | sync-upstream               | enter           |
+      | push-new-branches           | enter           |
+      | push-hook                   | enter           |
+      | ship-delete-tracking-branch | enter           |
+      | sync-before-ship            | enter           |
+      | save config to Git metadata | down enter      |
+
+  Scenario: result
+    Then local Git Town setting "sync-feature-strategy" is now "compress"
@@ -112,6 +112,16 @@ Feature: Accepting all default values leads to a working setup
       # If you are not sure, leave this empty.
       perennial-regex = ""
 
+      # All branches whose names match this regular expression
+      # are contribution branches: you add commits to them
+      # but somebody else owns, proposes, and ships them.
+      # contribution-regex = ""
+
+      # All branches whose names match this regular expression
+      # are observed branches: you follow their progress
+      # without adding commits to them.
+      # observed-regex = ""
+
       [hosting]
 
       # Knowing the type of code hosting platform allows Git Town
@@ -121,6 +121,16 @@ Feature: migrate existing configuration in Git metadata to a config file
       # If you are not sure, leave this empty.
       perennial-regex = "release-.*"
 
+      # All branches whose names match this regular expression
+      # are contribution branches: you add commits to them
+      # but somebody else owns, proposes, and ships them.
+      # contribution-regex = ""
+
+      # All branches whose names match this regular expression
+      # are observed branches: you follow their progress
+      # without adding commits to them.
+      # observed-regex = ""
+
       [hosting]
 
       # Knowing the type of code hosting platform allows Git Town
@@ -8,6 +8,7 @@ Feature: show the configuration
     And the observed branches "observed-1" and "observed-2"
     And the contribution branches "contribution-1" and "contribution-2"
     And the parked branches "parked-1" and "parked-2"
+    And local Git Town setting "prototype-branches" is "prototype-1 prototype-2"
     When I run "git-town config"
     Then it prints:
       """
@@ -17,7 +18,11 @@ Feature: show the configuration
         perennial regex: release-.*
         parked branches: parked-1, parked-2
         contribution branches: contribution-1, contribution-2
+        contribution regex: (not set)
         observed branches: observed-1, observed-2
+        observed regex: (not set)
+        prototype branches: prototype-1, prototype-2
+        branch type rules: (none)
 
       Configuration:
         offline: no
@@ -31,9 +36,15 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        hosting connector command: (not set)
+        sync proposal stacks: no
+        proposal stack template: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
       """
 
   Scenario: all configured in config file
@@ -47,6 +58,16 @@ Feature: show the configuration
       main = "main"
       perennials = [ "public", "staging" ]
       perennial-regex = "release-.*"
+      contribution-regex = "^coworker/"
+      observed-regex = "^dependabot/"
+
+      [[branches.rules]]
+      branches = "renovate/*"
+      type = "observed"
+
+      [[branches.rules]]
+      regex = "^qa-\\d+$"
+      type = "perennial"
 
       [hosting]
       platform = "github"
@@ -65,7 +86,11 @@ Feature: show the configuration
         perennial regex: release-.*
         parked branches: (none)
         contribution branches: (none)
+        contribution regex: ^coworker/
         observed branches: (none)
+        observed regex: ^dependabot/
+        prototype branches: (none)
+        branch type rules: observed=renovate/* perennial=/^qa-\d+$/
 
       Configuration:
         offline: no
@@ -79,9 +104,15 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: github
+        hosting connector command: (not set)
+        sync proposal stacks: no
+        proposal stack template: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
       """
 
   Scenario: configured in both Git and config file
@@ -124,7 +155,11 @@ Feature: show the configuration
         perennial regex: git-perennial-.*
         parked branches: parked-1, parked-2
         contribution branches: contribution-1, contribution-2
+        contribution regex: (not set)
         observed branches: observed-1, observed-2
+        observed regex: (not set)
+        prototype branches: (none)
+        branch type rules: (none)
 
       Configuration:
         offline: no
@@ -138,9 +173,15 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: github
+        hosting connector command: (not set)
+        sync proposal stacks: no
+        proposal stack template: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
       """
 
   Scenario: all configured, with stacked changes
@@ -157,7 +198,11 @@ Feature: show the configuration
         perennial regex: (not set)
         parked branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        prototype branches: (none)
+        branch type rules: (none)
 
       Configuration:
         offline: no
@@ -171,9 +216,15 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        hosting connector command: (not set)
+        sync proposal stacks: no
+        proposal stack template: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
 
       Branch Lineage:
         main
@@ -183,6 +234,33 @@ Feature: show the configuration
 
         qa
           hotfix
+
+      Sync Strategies:
+        alpha: merge
+        beta: merge
+        child: merge
+        hotfix: merge
+      """
+
+  Scenario: per-branch sync strategies
+    Given the feature branches "alpha", "beta", and "shared/one"
+    And Git Town sync-strategy setting for branch "alpha" is "rebase"
+    And the configuration file:
+      """
+      [sync-strategy]
+      feature-branches = "merge"
+
+      [[sync-strategy.overrides]]
+      branches = "shared/*"
+      strategy = "compress"
+      """
+    When I run "git-town config"
+    Then it prints:
+      """
+      Sync Strategies:
+        alpha: rebase
+        beta: merge
+        shared/one: compress
       """
 
   Scenario: no configuration data
@@ -196,7 +274,11 @@ Feature: show the configuration
         perennial regex: (not set)
         parked branches: (none)
         contribution branches: (none)
+        contribution regex: (not set)
         observed branches: (none)
+        observed regex: (not set)
+        prototype branches: (none)
+        branch type rules: (none)
 
       Configuration:
         offline: no
@@ -210,7 +292,13 @@ Feature: show the configuration
 
       Hosting:
         hosting platform override: (not set)
+        hosting connector command: (not set)
+        sync proposal stacks: no
+        proposal stack template: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
+        Bitbucket username: (not set)
+        Bitbucket app password: (not set)
       """
@@ -0,0 +1,18 @@
+Feature: making the current prototype branch a feature branch
+
+  Background:
+    Given the current branch is a local prototype branch "prototype"
+    When I run "git-town hack"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "prototype" is now a feature branch
+      """
+    And branch "prototype" is now a feature branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And branch "prototype" is now a prototype branch
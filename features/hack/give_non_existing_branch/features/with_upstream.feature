@@ -11,17 +11,17 @@ Feature: on a forked repo
 
   Scenario: result
     Then it runs the commands
-      | BRANCH | COMMAND                  |
-      | main   | git fetch --prune --tags |
-      |        | git add -A               |
-      |        | git stash                |
-      |        | git rebase origin/main   |
-      |        | git fetch upstream main  |
-      |        | git rebase upstream/main |
-      |        | git push                 |
-      |        | git branch new main      |
-      |        | git checkout new         |
-      | new    | git stash pop            |
+      | BRANCH | COMMAND                                                      |
+      | main   | git fetch --prune --tags --multiple --jobs=2 origin upstream |
+      |        | git add -A                                                   |
+      |        | git stash                                                    |
+      |        | git rebase origin/main                                       |
+      |        | git fetch upstream main                                      |
+      |        | git rebase upstream/main                                     |
+      |        | git push                                                     |
+      |        | git branch new main                                          |
+      |        | git checkout new                                             |
+      | new    | git stash pop                                                |
     And the current branch is now "new"
     And the uncommitted file still exists
     And these commits exist now
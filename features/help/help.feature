@@ -12,11 +12,13 @@ Feature: help for commands
     Examples:
       | COMMAND       |
       | append        |
+      | branch        |
       | completions   |
       | config        |
       | diff-parent   |
       | hack          |
       | help          |
+      | history       |
       | kill          |
       | offline       |
       | prepend       |
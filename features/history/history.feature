@@ -0,0 +1,28 @@
+Feature: display the Git Town commands that can be undone
+
+  Scenario: several commands ran
+    Given the current branch is a feature branch "existing"
+    And I ran "git-town hack first"
+    And I ran "git-town hack second"
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1. hack \(.*\):
+        status: finished
+        branches before: 3 local branches, "first" checked out
+        branches after: 4 local branches, "second" checked out
+        can be undone: yes
+
+      2. hack \(.*\):
+        status: finished
+        branches before: 2 local branches, "existing" checked out
+        branches after: 3 local branches, "first" checked out
+        can be undone: yes
+      """
+
+  Scenario: no commands ran
+    When I run "git-town history"
+    Then it prints:
+      """
+      nothing to undo
+      """
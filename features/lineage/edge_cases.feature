@@ -0,0 +1,51 @@
+Feature: edge cases of sharing the lineage
+
+  Scenario: parent branches in the local configuration take precedence
+    Given a feature branch "feature"
+    And a coworker clones the repository
+    And the coworker runs "git-town hack other"
+    And the coworker runs "git push --all origin"
+    And the coworker is on the "feature" branch
+    And the coworker sets the parent branch of "feature" as "other"
+    And the coworker runs "git-town lineage push"
+    And the current branch is "feature"
+    When I run "git-town lineage pull"
+    Then it prints:
+      """
+      the shared lineage contains no new parent branches
+      """
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+
+  Scenario: origin contains the shared lineage of a coworker
+    Given a coworker clones the repository
+    And the coworker runs "git-town hack coworker-parent"
+    And the coworker runs "git-town append coworker-child"
+    And the coworker runs "git push --all origin"
+    And the coworker runs "git-town lineage push"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the current branch is "child"
+    When I run "git-town lineage push"
+    Then it prints:
+      """
+      shared the parent branches of 4 branches
+      """
+    And origin now has this shared lineage:
+      """
+      child parent
+      coworker-child coworker-parent
+      coworker-parent main
+      parent main
+      """
+
+  Scenario: no origin
+    Given my repo does not have an origin
+    And the current branch is a local feature branch "feature"
+    When I run "git-town lineage push"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot share the lineage because this repository has no "origin" remote
+      """
@@ -0,0 +1,38 @@
+Feature: Git Town commands use the shared lineage
+
+  Background:
+    Given a coworker clones the repository
+    And I ran "git-town lineage pull"
+    And the coworker runs "git-town hack parent"
+    And the coworker runs "git-town append child"
+    And the coworker runs "git push --all origin"
+    And the coworker runs "git-town lineage push"
+    And I ran "git fetch"
+    And I ran "git checkout parent"
+    And I ran "git checkout child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                           |
+      | child  | git fetch --prune --tags          |
+      |        | git checkout main                 |
+      | main   | git rebase origin/main            |
+      |        | git checkout parent               |
+      | parent | git merge --no-edit origin/parent |
+      |        | git merge --no-edit main          |
+      |        | git checkout child                |
+      | child  | git merge --no-edit origin/child  |
+      |        | git merge --no-edit parent        |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
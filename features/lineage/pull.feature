@@ -0,0 +1,27 @@
+Feature: pull the parent branches that a coworker shared
+
+  Background:
+    Given a coworker clones the repository
+    And the coworker runs "git-town hack parent"
+    And the coworker runs "git-town append child"
+    And the coworker runs "git push --all origin"
+    And the coworker runs "git-town lineage push"
+    And a branch "parent"
+    And the current branch is "child"
+    When I run "git-town lineage pull"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                               |
+      | child  | git config --add remote.origin.fetch +refs/git-town/*:refs/git-town/* |
+      |        | git fetch --prune --tags                                              |
+    And it prints:
+      """
+      branch "child" now has the parent branch "parent"
+      branch "parent" now has the parent branch "main"
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
+    And local Git Town setting "share-lineage" is now "true"
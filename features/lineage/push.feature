@@ -0,0 +1,26 @@
+Feature: share the parent branches with the other clones of the repository
+
+  Background:
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And a local feature branch "local"
+    And the current branch is "child"
+    When I run "git-town lineage push"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                         |
+      | child  | git config --add remote.origin.fetch +refs/git-town/*:refs/git-town/*           |
+      |        | git fetch --prune --tags                                                        |
+      |        | git push --force-with-lease=refs/git-town/lineage: origin refs/git-town/lineage |
+    And it prints:
+      """
+      shared the parent branches of 2 branches
+      """
+    And origin now has this shared lineage:
+      """
+      child parent
+      parent main
+      """
+    And local Git Town setting "share-lineage" is now "true"
+    And the initial branches and lineage exist
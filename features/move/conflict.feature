@@ -0,0 +1,62 @@
+Feature: conflicts while moving a branch
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME        | FILE CONTENT   |
+      | parent | local, origin | parent commit | conflicting_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME        | FILE CONTENT  |
+      | child  | local, origin | child commit | conflicting_file | child content |
+    And the current branch is "child"
+    When I run "git-town move --up"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | child  | git fetch --prune --tags      |
+      |        | git rebase --onto main parent |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git rebase --abort |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And the initial lineage exists
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout parent                             |
+      | parent | git rebase child                                |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      """
+    And a rebase is now in progress
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | parent | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout child                              |
+    And no rebase is in progress
+    And the current branch is still "child"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | child  |
@@ -0,0 +1,78 @@
+Feature: move a branch down in the stack
+
+  Background:
+    Given the current branch is a feature branch "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+    And a feature branch "beta" as a child of "alpha"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     | FILE NAME | FILE CONTENT |
+      | beta   | local, origin | beta commit | beta_file | beta content |
+    And a feature branch "gamma" as a child of "beta"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | gamma  | local, origin | gamma commit | gamma_file | gamma content |
+    And the current branch is "alpha"
+    When I run "git-town move --down"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                    |
+      | alpha  | git fetch --prune --tags                                   |
+      |        | git checkout beta                                          |
+      | beta   | git rebase --onto main alpha                               |
+      |        | git push --force-with-lease --force-if-includes            |
+      |        | git checkout alpha                                         |
+      | alpha  | git rebase beta                                            |
+      |        | git push --force-with-lease --force-if-includes            |
+      |        | git checkout gamma                                         |
+      | gamma  | git rebase --onto alpha {{ sha-before-run 'beta commit' }} |
+      |        | git push --force-with-lease --force-if-includes            |
+      |        | git checkout alpha                                         |
+    And it prints:
+      """
+      branch "gamma" is now a child of "alpha"
+      """
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | beta commit  |
+      |        |               | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | beta commit  |
+      |        |               | alpha commit |
+      |        |               | gamma commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | beta   |
+      | beta   | main   |
+      | gamma  | alpha  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      | alpha  | git reset --hard {{ sha-before-run 'alpha commit' }} |
+      |        | git push --force-with-lease --force-if-includes      |
+      |        | git checkout beta                                    |
+      | beta   | git reset --hard {{ sha-before-run 'beta commit' }}  |
+      |        | git push --force-with-lease --force-if-includes      |
+      |        | git checkout gamma                                   |
+      | gamma  | git reset --hard {{ sha-before-run 'gamma commit' }} |
+      |        | git push --force-with-lease --force-if-includes      |
+      |        | git checkout alpha                                   |
+    And the current branch is still "alpha"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | alpha commit |
+      |        |               | beta commit  |
+      | gamma  | local, origin | alpha commit |
+      |        |               | beta commit  |
+      |        |               | gamma commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+      | gamma  | beta   |
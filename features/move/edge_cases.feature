@@ -0,0 +1,102 @@
+Feature: cannot move some branches
+
+  Scenario: no direction given
+    Given the current branch is a feature branch "feature"
+    When I run "git-town move"
+    Then it runs no commands
+    And it prints the error:
+      """
+      please provide either --up or --down
+      """
+
+  Scenario: both directions given
+    Given the current branch is a feature branch "feature"
+    When I run "git-town move --up --down"
+    Then it runs no commands
+    And it prints the error:
+      """
+      please provide either --up or --down
+      """
+
+  Scenario: main branch
+    Given the current branch is "main"
+    When I run "git-town move --down"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And it prints the error:
+      """
+      the branch "main" is not a feature branch. Only feature branches can have parent branches
+      """
+
+  Scenario: move up a branch whose parent is the main branch
+    Given the current branch is a feature branch "feature"
+    When I run "git-town move --up"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot move branch "feature" up because its parent "main" is not a feature branch
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+
+  Scenario: move down a branch without children
+    Given the current branch is a feature branch "feature"
+    When I run "git-town move --down"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot move branch "feature" down because it has no child branches
+      """
+
+  Scenario: move down a branch with several children
+    Given a feature branch "parent"
+    And a feature branch "child-1" as a child of "parent"
+    And a feature branch "child-2" as a child of "parent"
+    And the current branch is "parent"
+    When I run "git-town move --down"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | parent | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot move branch "parent" down because it has several child branches
+      """
+
+  Scenario: move up a branch whose parent has several children
+    Given a feature branch "parent"
+    And a feature branch "child-1" as a child of "parent"
+    And a feature branch "child-2" as a child of "parent"
+    And the current branch is "child-1"
+    When I run "git-town move --up"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | child-1 | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot move branch "child-1" up because its parent "parent" has several child branches
+      """
+    And the current branch is still "child-1"
+    And the initial branches and lineage exist
+
+  Scenario: unsynced branch
+    Given a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE         |
+      | parent | local    | unpushed commit |
+    And the current branch is "child"
+    When I run "git-town move --up"
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | child  | git fetch --prune --tags |
+    And it prints the error:
+      """
+      please sync branch "parent" before moving it
+      """
+    And the current branch is still "child"
+    And the initial branches and lineage exist
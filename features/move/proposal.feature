@@ -0,0 +1,46 @@
+@skipWindows
+Feature: move a branch that has a proposal
+
+  Background:
+    Given the current branch is a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And the current branch is "child"
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "parent", "Title": "child", "MergeWithAPI": true}}
+      """
+    When I run "git-town move --up"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                     |
+      | child  | git fetch --prune --tags                                                    |
+      |        | git rebase --onto main parent                                               |
+      |        | git push --force-with-lease --force-if-includes                             |
+      |        | git checkout parent                                                         |
+      | parent | git rebase child                                                            |
+      |        | git push --force-with-lease --force-if-includes                             |
+      | <none> | Hosting connector: updating target branch for proposal #1 to "main" ... ok  |
+      |        | Hosting connector: updating target branch for proposal #1 to "child" ... ok |
+      | parent | git checkout child                                                          |
+    And the current branch is still "child"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                                      |
+      |        | Hosting connector: updating target branch for proposal #1 to "main" ... ok   |
+      |        | Hosting connector: updating target branch for proposal #1 to "parent" ... ok |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }}                         |
+      |        | git push --force-with-lease --force-if-includes                              |
+      |        | git checkout parent                                                          |
+      | parent | git reset --hard {{ sha 'parent commit' }}                                   |
+      |        | git push --force-with-lease --force-if-includes                              |
+      |        | git checkout child                                                           |
+    And the current branch is still "child"
@@ -0,0 +1,60 @@
+@smoke
+Feature: move a branch up in the stack
+
+  Background:
+    Given the current branch is a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And the current branch is "child"
+    When I run "git-town move --up"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git fetch --prune --tags                        |
+      |        | git rebase --onto main parent                   |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout parent                             |
+      | parent | git rebase child                                |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout child                              |
+    And it prints:
+      """
+      branch "child" is now a child of "main"
+      """
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | child commit  |
+      | parent | local, origin | child commit  |
+      |        |               | parent commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | child  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }} |
+      |        | git push --force-with-lease --force-if-includes      |
+      |        | git checkout parent                                  |
+      | parent | git reset --hard {{ sha 'parent commit' }}           |
+      |        | git push --force-with-lease --force-if-includes      |
+      |        | git checkout child                                   |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | parent commit |
+      |        |               | child commit  |
+      | parent | local, origin | parent commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | parent |
+      | parent | main   |
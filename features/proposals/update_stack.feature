@@ -0,0 +1,31 @@
+@skipWindows
+Feature: add stack navigation to the proposals of a stack
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+
+  Scenario: stacked branch
+    Given the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    When I run "git-town proposals update-stack"
+    Then it prints:
+      """
+      Hosting connector: updating description of proposal #1 ... ok
+      """
+
+  Scenario: branch outside a stack
+    Given the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    And a feature branch "gamma"
+    And the current branch is "gamma"
+    When I run "git-town proposals update-stack"
+    Then it prints the error:
+      """
+      branch "gamma" is not part of a stack
+      """
@@ -0,0 +1,31 @@
+@skipWindows
+Feature: Create proposals for prototype branches
+
+  Background:
+    Given the current branch is a local prototype branch "prototype"
+    And tool "open" is installed
+    And the origin is "git@github.com:git-town/git-town.git"
+    When I run "git-town propose"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH    | COMMAND                                                              |
+      | prototype | git fetch --prune --tags                                             |
+      |           | git checkout main                                                    |
+      | main      | git rebase origin/main                                               |
+      |           | git checkout prototype                                               |
+      | prototype | git merge --no-edit main                                             |
+      |           | git push -u origin prototype                                         |
+      | <none>    | open https://github.com/git-town/git-town/compare/prototype?expand=1 |
+    And "open" launches a new proposal with this url in my browser:
+      """
+      https://github.com/git-town/git-town/compare/prototype?expand=1
+      """
+    And branch "prototype" is now a feature branch
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH    | COMMAND                    |
+      | prototype | git push origin :prototype |
+    And branch "prototype" is now a prototype branch
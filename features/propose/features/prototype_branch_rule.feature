@@ -0,0 +1,21 @@
+Feature: Cannot create proposals for branches that a branch type rule makes prototype branches
+
+  Background:
+    Given the current branch is a feature branch "spike/cache"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And the configuration file:
+      """
+      [[branches.rules]]
+      branches = "spike/*"
+      type = "prototype"
+      """
+    When I run "git-town propose"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH      | COMMAND                  |
+      | spike/cache | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot propose branch "spike/cache" because branch type rule "prototype=spike/*" makes it a prototype branch
+      """
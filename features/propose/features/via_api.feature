@@ -0,0 +1,43 @@
+@skipWindows
+Feature: create proposals via the API of the hosting platform
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And tool "open" is installed
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+
+  Scenario: open the created proposal
+    When I run "git-town propose --title 'my title' --body 'my body'"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                              |
+      | feature | git fetch --prune --tags                                             |
+      |         | git checkout main                                                    |
+      | main    | git rebase origin/main                                               |
+      |         | git checkout feature                                                 |
+      | feature | git merge --no-edit origin/feature                                   |
+      |         | git merge --no-edit main                                             |
+      | <none>  | Hosting connector: creating proposal from "feature" to "main" ... ok |
+      |         | open https://review.example.com/proposals/1                          |
+    And it prints:
+      """
+      Created proposal: https://review.example.com/proposals/1
+      """
+
+  Scenario: headless
+    When I run "git-town propose --draft --no-browser"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                              |
+      | feature | git fetch --prune --tags                                             |
+      |         | git checkout main                                                    |
+      | main    | git rebase origin/main                                               |
+      |         | git checkout feature                                                 |
+      | feature | git merge --no-edit origin/feature                                   |
+      |         | git merge --no-edit main                                             |
+      | <none>  | Hosting connector: creating proposal from "feature" to "main" ... ok |
+    And it prints:
+      """
+      Created proposal: https://review.example.com/proposals/1
+      """
@@ -0,0 +1,27 @@
+Feature: prototype the current branch
+
+  Background:
+    Given the current branch is a feature branch "branch"
+    And an uncommitted file
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "branch" is now a prototype branch
+      """
+    And the current branch is still "branch"
+    And branch "branch" is now a prototype branch
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND       |
+      | branch | git add -A    |
+      |        | git stash     |
+      |        | git stash pop |
+    And the current branch is still "branch"
+    And there are now no prototype branches
+    And the uncommitted file still exists
@@ -0,0 +1,22 @@
+Feature: cannot prototype a prototype branch
+
+  Background:
+    Given the current branch is a local prototype branch "prototype"
+    And an uncommitted file
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      branch "prototype" is already a prototype branch
+      """
+    And the current branch is still "prototype"
+    And branch "prototype" is still a prototype branch
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "prototype"
+    And branch "prototype" is still a prototype branch
@@ -0,0 +1,22 @@
+Feature: cannot prototype non-existing branches
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And an uncommitted file
+    When I run "git-town prototype feature non-existing"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      there is no branch "non-existing"
+      """
+    And the current branch is still "feature"
+    And the uncommitted file still exists
+    And there are still no prototype branches
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And there are still no prototype branches
+    And the current branch is still "feature"
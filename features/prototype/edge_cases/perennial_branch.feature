@@ -0,0 +1,25 @@
+Feature: cannot prototype perennial branches
+
+  Background:
+    Given the current branch is a perennial branch "perennial"
+    And an uncommitted file
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot make perennial branches prototype branches
+      """
+    And the current branch is still "perennial"
+    And the perennial branches are still "perennial"
+    And the uncommitted file still exists
+    And there are still no prototype branches
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "perennial"
+    And the uncommitted file still exists
+    And the perennial branches are still "perennial"
+    And there are still no prototype branches
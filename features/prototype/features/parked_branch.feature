@@ -0,0 +1,29 @@
+Feature: prototype a parked branch
+
+  Background:
+    Given the current branch is a parked branch "parked"
+    And an uncommitted file
+    When I run "git-town prototype"
+
+  Scenario: result
+    Then it runs no commands
+    And it prints:
+      """
+      branch "parked" is now a prototype branch
+      """
+    And the current branch is still "parked"
+    And branch "parked" is now a prototype branch
+    And there are now no parked branches
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND       |
+      | parked | git add -A    |
+      |        | git stash     |
+      |        | git stash pop |
+    And the current branch is still "parked"
+    And branch "parked" is now parked
+    And there are now no prototype branches
+    And the uncommitted file still exists
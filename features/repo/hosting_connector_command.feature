@@ -0,0 +1,26 @@
+@skipWindows
+Feature: hosting connector command
+
+  Scenario: external connector provides the repository URL
+    Given tool "open" is installed
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"URL": "https://review.example.com/git-town/git-town"}
+      """
+    When I run "git-town repo"
+    Then "open" launches a new proposal with this url in my browser:
+      """
+      https://review.example.com/git-town/git-town
+      """
+
+  Scenario: external connector fails
+    Given tool "open" is installed
+    And the hosting connector command "review-tool" responds with:
+      """
+      not JSON
+      """
+    When I run "git-town repo"
+    Then it prints:
+      """
+      FAILED: hosting connector command "review-tool" provided invalid output for RepositoryURL
+      """
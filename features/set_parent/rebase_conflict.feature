@@ -0,0 +1,58 @@
+Feature: conflicts while rebasing the current branch onto its new parent
+
+  Background:
+    Given a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME        | FILE CONTENT   |
+      | parent | local, origin | parent commit | conflicting_file | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME        | FILE CONTENT  |
+      | child  | local, origin | child commit | conflicting_file | child content |
+    And the current branch is "child"
+    When I run "git-town set-parent --rebase" and enter into the dialog:
+      | DIALOG                 | KEYS       |
+      | parent branch of child | down enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                       |
+      | child  | git rebase --onto main parent |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND            |
+      | child  | git rebase --abort |
+    And the current branch is still "child"
+    And no rebase is in progress
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | parent commit |
+      |        |               | child commit  |
+      | parent | local, origin | parent commit |
+    And the initial lineage exists
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git rebase --continue                           |
+      |        | git push --force-with-lease --force-if-includes |
+    And no rebase is in progress
+    And the current branch is still "child"
+    And these committed files exist now
+      | BRANCH | NAME             | CONTENT          |
+      | child  | conflicting_file | resolved content |
+      | parent | conflicting_file | parent content   |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+      | parent | main   |
@@ -12,6 +12,7 @@ Feature: require minimum Git version
     Examples:
       | COMMAND           |
       | append foo        |
+      | branch            |
       | config            |
       | config setup      |
       | diff-parent       |
@@ -14,8 +14,12 @@ Feature: too few or many parameters
       | append arg1 arg2             | accepts 1 arg(s), received 2                       |
       | completions arg1             | unknown completion type: "arg1"                    |
       | config arg1                  | unknown command "arg1" for "git-town config"       |
+      | config branch-type arg1 arg2 | accepts at most 1 arg(s), received 2               |
       | config setup arg1            | unknown command "arg1" for "git-town config setup" |
       | kill arg1 arg2               | accepts at most 1 arg(s), received 2               |
+      | lineage push arg1            | unknown command "arg1" for "git-town lineage push" |
+      | lineage pull arg1            | unknown command "arg1" for "git-town lineage pull" |
+      | move arg1                    | unknown command "arg1" for "git-town move"         |
       | offline arg1 arg2            | accepts at most 1 arg(s), received 2               |
       | propose arg1                 | unknown command "arg1" for "git-town propose"      |
       | prepend                      | accepts 1 arg(s), received 0                       |
@@ -24,5 +28,9 @@ Feature: too few or many parameters
       | repo arg1                    | unknown command "arg1" for "git-town repo"         |
       | set-parent arg1              | unknown command "arg1" for "git-town set-parent"   |
       | ship arg1 arg2               | accepts at most 1 arg(s), received 2               |
+      | split                        | accepts 1 arg(s), received 0                       |
+      | split arg1 arg2              | accepts 1 arg(s), received 2                       |
       | sync arg1                    | unknown command "arg1" for "git-town sync"         |
+      | worktree                     | accepts between 1 and 2 arg(s), received 0         |
+      | worktree arg1 arg2 arg3      | accepts between 1 and 2 arg(s), received 3         |
       | --version arg1               | unknown command "arg1" for "git-town"              |
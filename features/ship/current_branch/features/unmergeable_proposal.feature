@@ -0,0 +1,30 @@
+@skipWindows
+Feature: cannot ship proposals that the hosting platform would not merge
+
+  Scenario Outline:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "feature", "MergeWithAPI": true, <PROPOSAL>}}
+      """
+    When I run "git-town ship"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      <ERROR>
+      """
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
+
+    Examples:
+      | PROPOSAL                              | ERROR                                                                                    |
+      | "Draft": true                         | cannot ship branch "feature" because proposal #1 is still a draft                        |
+      | "Checks": "failing"                   | cannot ship branch "feature" because the CI checks of proposal #1 are failing            |
+      | "ReviewDecision": "changes-requested" | cannot ship branch "feature" because the reviewers of proposal #1 have requested changes |
+      | "ReviewDecision": "review-required"   | cannot ship branch "feature" because proposal #1 has not been approved yet               |
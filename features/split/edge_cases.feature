@@ -0,0 +1,66 @@
+Feature: cannot split some branches
+
+  Scenario: branch with a single commit
+    Given the current branch is a local feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  |
+      | feature | local    | commit 1 |
+    When I run "git-town split first"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      branch "feature" needs at least two commits to split it
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist
+
+  Scenario: unsynced branch
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  |
+      | feature | local    | commit 1 |
+      |         |          | commit 2 |
+    When I run "git-town split first"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      please sync branch "feature" before splitting it
+      """
+
+  Scenario: perennial branch
+    Given the current branch is a perennial branch "production"
+    When I run "git-town split first"
+    Then it runs the commands
+      | BRANCH     | COMMAND                  |
+      | production | git fetch --prune --tags |
+    And it prints the error:
+      """
+      cannot split perennial branches
+      """
+
+  Scenario: observed branch
+    Given the current branch is an observed branch "observed"
+    When I run "git-town split first"
+    Then it runs the commands
+      | BRANCH   | COMMAND                  |
+      | observed | git fetch --prune --tags |
+    And it prints the error:
+      """
+      you are merely observing branch "observed" and should leave splitting it to the branch owner
+      """
+
+  Scenario: branch to create already exists
+    Given the current branch is a local feature branch "feature"
+    And a local feature branch "first"
+    When I run "git-town split first"
+    Then it runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And it prints the error:
+      """
+      there is already a branch "first"
+      """
@@ -0,0 +1,44 @@
+@smoke
+Feature: split a local feature branch
+
+  Background:
+    Given the current branch is a local feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local    | commit 1 | file_1    | content 1    |
+      |         |          | commit 2 | file_2    | content 2    |
+      |         |          | commit 3 | file_3    | content 3    |
+    When I run "git-town split first" and enter into the dialog:
+      | DIALOG       | KEYS       |
+      | split commit | down enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                    |
+      | feature | git fetch --prune --tags                   |
+      |         | git branch first {{ full-sha 'commit 2' }} |
+    And it prints:
+      """
+      branch "feature" is now a child of "first"
+      """
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION | MESSAGE  |
+      | feature | local    | commit 1 |
+      |         |          | commit 2 |
+      |         |          | commit 3 |
+      | first   | local    | commit 1 |
+      |         |          | commit 2 |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | first  |
+      | first   | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND             |
+      | feature | git branch -D first |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,40 @@
+@skipWindows
+Feature: split a feature branch that has a proposal
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "feature", "MergeWithAPI": true}}
+      """
+    When I run "git-town split first" and enter into the dialog:
+      | DIALOG       | KEYS  |
+      | split commit | enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                     |
+      | feature | git fetch --prune --tags                                                    |
+      |         | git branch first {{ full-sha 'commit 1' }}                                  |
+      |         | git push -u origin first                                                    |
+      | <none>  | Hosting connector: updating target branch for proposal #1 to "first" ... ok |
+    And the current branch is still "feature"
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | first  |
+      | first   | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                    |
+      |         | Hosting connector: updating target branch for proposal #1 to "main" ... ok |
+      | feature | git push origin :first                                                     |
+      |         | git branch -D first                                                        |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
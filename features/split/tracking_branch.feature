@@ -0,0 +1,38 @@
+Feature: split a feature branch that has a tracking branch
+
+  Background:
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    When I run "git-town split first" and enter into the dialog:
+      | DIALOG       | KEYS  |
+      | split commit | enter |
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                    |
+      | feature | git fetch --prune --tags                   |
+      |         | git branch first {{ full-sha 'commit 1' }} |
+      |         | git push -u origin first                   |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+      |         |               | commit 2 |
+      | first   | local, origin | commit 1 |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | first  |
+      | first   | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                |
+      | feature | git push origin :first |
+      |         | git branch -D first    |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,59 @@
+Feature: describe the status of the current/last Git Town command in JSON
+
+  Scenario: Git Town command ran successfully
+    Given I ran "git-town sync"
+    When I run "git-town status --json"
+    Then it prints:
+      """
+      "canContinue": false,
+        "canSkip": false,
+        "canUndo": true,
+      """
+    And it prints:
+      """
+      "finished": true,
+      """
+    And it prints:
+      """
+      "Command": "sync",
+      """
+
+  Scenario: Git Town command in progress
+    Given the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | conflicting_file | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And I run "git-town sync"
+    When I run "git-town status --json"
+    Then it prints:
+      """
+      "canContinue": true,
+        "canSkip": true,
+        "canUndo": true,
+      """
+    And it prints:
+      """
+      "finished": false,
+      """
+    And it prints:
+      """
+      "CanSkip": true,
+            "EndBranch": "feature",
+      """
+
+  Scenario: no runstate exists
+    When I run "git-town status --json"
+    Then it prints:
+      """
+      "finished": false,
+        "runState": null
+      }
+      """
+
+  Scenario: subcommand without JSON support
+    When I run "git-town status reset --json"
+    Then it prints the error:
+      """
+      unknown flag: --json
+      """
@@ -0,0 +1,29 @@
+Feature: sync a feature branch that is already compressed using the "compress" sync-feature strategy
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "compress"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      |         | git checkout main                  |
+      | main    | git rebase origin/main             |
+      |         | git checkout feature               |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And the initial commits exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs no commands
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
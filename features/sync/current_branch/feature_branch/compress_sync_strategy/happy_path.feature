@@ -0,0 +1,52 @@
+Feature: sync the current feature branch using the "compress" sync-feature strategy
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "compress"
+    And the current branch is a feature branch "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               | FILE NAME     |
+      | main    | local    | local main commit     | main_file     |
+      |         | origin   | origin main commit    | origin_file   |
+      | feature | local    | first feature commit  | feature_file1 |
+      |         | local    | second feature commit | feature_file2 |
+      |         | origin   | origin feature commit | feature_file3 |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git fetch --prune --tags                        |
+      |         | git checkout main                               |
+      | main    | git rebase origin/main                          |
+      |         | git push                                        |
+      |         | git checkout feature                            |
+      | feature | git merge --no-edit origin/feature              |
+      |         | git merge --no-edit main                        |
+      |         | git reset --soft main                           |
+      |         | git commit -m "first feature commit"            |
+      |         | git push --force-with-lease --force-if-includes |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE              | FILE NAME                                   |
+      | main    | local, origin | origin main commit   | origin_file                                 |
+      |         |               | local main commit    | main_file                                   |
+      | feature | local, origin | origin main commit   | origin_file                                 |
+      |         |               | local main commit    | main_file                                   |
+      |         |               | first feature commit | feature_file1, feature_file2, feature_file3 |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                                                |
+      | feature | git reset --hard {{ sha 'second feature commit' }}                                     |
+      |         | git push --force-with-lease origin {{ sha-in-origin 'origin feature commit' }}:feature |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE               |
+      | main    | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      | feature | local         | first feature commit  |
+      |         |               | second feature commit |
+      |         | origin        | origin feature commit |
+    And the initial branches and lineage exist
@@ -11,17 +11,17 @@ Feature: with upstream repo
 
   Scenario: result
     Then it runs the commands
-      | BRANCH  | COMMAND                            |
-      | feature | git fetch --prune --tags           |
-      |         | git checkout main                  |
-      | main    | git rebase origin/main             |
-      |         | git fetch upstream main            |
-      |         | git rebase upstream/main           |
-      |         | git push                           |
-      |         | git checkout feature               |
-      | feature | git merge --no-edit origin/feature |
-      |         | git merge --no-edit main           |
-      |         | git push                           |
+      | BRANCH  | COMMAND                                                      |
+      | feature | git fetch --prune --tags --multiple --jobs=2 origin upstream |
+      |         | git checkout main                                            |
+      | main    | git rebase origin/main                                       |
+      |         | git fetch upstream main                                      |
+      |         | git rebase upstream/main                                     |
+      |         | git push                                                     |
+      |         | git checkout feature                                         |
+      | feature | git merge --no-edit origin/feature                           |
+      |         | git merge --no-edit main                                     |
+      |         | git push                                                     |
     And all branches are now synchronized
     And the current branch is still "feature"
     And these commits exist now
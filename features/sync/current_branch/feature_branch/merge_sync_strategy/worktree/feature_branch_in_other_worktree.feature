@@ -16,19 +16,22 @@ Feature: Sync a feature branch that is in another worktree than the main branch
     Then it runs the commands
       | BRANCH  | COMMAND                            |
       | feature | git fetch --prune --tags           |
-      |         | git merge --no-edit origin/feature |
-      |         | git merge --no-edit origin/main    |
+      | main    | git rebase origin/main             |
+      |         | git push                           |
+      | feature | git merge --no-edit origin/feature |
+      |         | git merge --no-edit main           |
       |         | git push                           |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
       | BRANCH  | LOCATION         | MESSAGE                                                    |
-      | main    | local            | local main commit                                          |
-      |         | origin           | origin main commit                                         |
+      | main    | local, origin    | origin main commit                                         |
+      |         |                  | local main commit                                          |
       | feature | origin, worktree | local feature commit                                       |
       |         |                  | origin feature commit                                      |
       |         |                  | Merge remote-tracking branch 'origin/feature' into feature |
       |         |                  | origin main commit                                         |
-      |         |                  | Merge remote-tracking branch 'origin/main' into feature    |
+      |         |                  | local main commit                                          |
+      |         |                  | Merge branch 'main' into feature                           |
 
   Scenario: undo
     When I run "git-town undo" in the other worktree
@@ -38,9 +41,9 @@ Feature: Sync a feature branch that is in another worktree than the main branch
       |         | git push --force-with-lease origin {{ sha-in-origin 'origin feature commit' }}:feature |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
-      | BRANCH  | LOCATION | MESSAGE               |
-      | main    | local    | local main commit     |
-      |         | origin   | origin main commit    |
-      | feature | origin   | origin feature commit |
-      |         | worktree | local feature commit  |
+      | BRANCH  | LOCATION      | MESSAGE               |
+      | main    | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      | feature | origin        | origin feature commit |
+      |         | worktree      | local feature commit  |
     And the initial branches and lineage exist
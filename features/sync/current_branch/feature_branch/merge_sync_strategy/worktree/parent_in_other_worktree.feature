@@ -22,29 +22,43 @@ Feature: sync a branch whose parent is active in another worktree
       |        | git checkout main                 |
       | main   | git rebase origin/main            |
       |        | git push                          |
-      |        | git checkout child                |
+      | parent | git merge --no-edit origin/parent |
+      |        | git merge --no-edit main          |
+      |        | git push                          |
+      | main   | git checkout child                |
       | child  | git merge --no-edit origin/child  |
-      |        | git merge --no-edit origin/parent |
+      |        | git merge --no-edit parent        |
       |        | git push                          |
     And the current branch is still "child"
     And these commits exist now
-      | BRANCH | LOCATION                | MESSAGE                                                 |
-      | main   | local, origin, worktree | origin main commit                                      |
-      |        |                         | local main commit                                       |
-      | child  | local, origin           | local child commit                                      |
-      |        |                         | origin child commit                                     |
-      |        |                         | Merge remote-tracking branch 'origin/child' into child  |
-      |        |                         | origin parent commit                                    |
-      |        |                         | Merge remote-tracking branch 'origin/parent' into child |
-      | parent | origin                  | origin parent commit                                    |
-      |        | worktree                | local parent commit                                     |
+      | BRANCH | LOCATION                | MESSAGE                                                  |
+      | main   | local, origin, worktree | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      | child  | local, origin           | local child commit                                       |
+      |        |                         | origin child commit                                      |
+      |        |                         | Merge remote-tracking branch 'origin/child' into child   |
+      |        |                         | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
+      |        |                         | Merge branch 'parent' into child                         |
+      | parent | origin, worktree        | local parent commit                                      |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
+      |        |                         | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      |        |                         | Merge branch 'main' into parent                          |
 
   Scenario: undo
     When I run "git-town undo"
     Then it runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha 'local child commit' }}                                    |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                              |
+      | child  | git reset --hard {{ sha 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child   |
+      | parent | git reset --hard {{ sha 'local parent commit' }}                                     |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin parent commit' }}:parent |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
@@ -54,3 +68,20 @@ Feature: sync a branch whose parent is active in another worktree
       |        | origin                  | origin child commit  |
       | parent | origin                  | origin parent commit |
       |        | worktree                | local parent commit  |
+
+  Scenario: undo with uncommitted changes in the other worktree
+    Given an uncommitted file in the other worktree
+    When I run "git-town undo"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot undo the changes to branch "parent" because its worktree at
+      """
+    And the current branch is still "child"
+    And the uncommitted file still exists in the other worktree
+    When I run "git-town history"
+    Then it prints something like:
+      """
+      1. sync \(.*\):
+        status: finished
+      """
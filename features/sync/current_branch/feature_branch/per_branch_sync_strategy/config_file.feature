@@ -0,0 +1,40 @@
+Feature: sync feature branches whose sync-feature strategy is defined by rules in the config file
+
+  Background:
+    Given the feature branches "shared/alpha" and "personal"
+    And the current branch is "main"
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [sync-strategy]
+      feature-branches = "rebase"
+
+      [[sync-strategy.overrides]]
+      branches = "shared/*"
+      strategy = "merge"
+      """
+    And the commits
+      | BRANCH       | LOCATION | MESSAGE            |
+      | main         | origin   | origin main commit |
+      | shared/alpha | local    | alpha commit       |
+      | personal     | local    | personal commit    |
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH       | COMMAND                                         |
+      | main         | git fetch --prune --tags                        |
+      |              | git rebase origin/main                          |
+      |              | git checkout personal                           |
+      | personal     | git rebase main                                 |
+      |              | git push --force-with-lease --force-if-includes |
+      |              | git checkout shared/alpha                       |
+      | shared/alpha | git merge --no-edit origin/shared/alpha         |
+      |              | git merge --no-edit main                        |
+      |              | git push                                        |
+      |              | git checkout main                               |
+      | main         | git push --tags                                 |
+    And all branches are now synchronized
+    And the current branch is still "main"
@@ -0,0 +1,41 @@
+Feature: sync a feature branch that overrides the sync-feature strategy in the Git metadata
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "merge"
+    And the current branch is a feature branch "feature"
+    And Git Town sync-strategy setting for branch "feature" is "rebase"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE            |
+      | main    | origin   | origin main commit |
+      | feature | local    | local commit       |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH  | COMMAND                                         |
+      | feature | git fetch --prune --tags                        |
+      |         | git checkout main                               |
+      | main    | git rebase origin/main                          |
+      |         | git checkout feature                            |
+      | feature | git rebase main                                 |
+      |         | git push --force-with-lease --force-if-includes |
+    And all branches are now synchronized
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE            |
+      | main    | local, origin | origin main commit |
+      | feature | local, origin | origin main commit |
+      |         |               | local commit       |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH  | COMMAND                                                               |
+      | feature | git reset --hard {{ sha 'local commit' }}                             |
+      |         | git push --force-with-lease origin {{ sha 'initial commit' }}:feature |
+      |         | git checkout main                                                     |
+      | main    | git reset --hard {{ sha 'initial commit' }}                           |
+      |         | git checkout feature                                                  |
+    And the current branch is still "feature"
+    And the initial commits exist
+    And the initial branches and lineage exist
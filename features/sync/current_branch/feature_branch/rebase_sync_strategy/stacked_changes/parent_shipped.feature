@@ -0,0 +1,52 @@
+Feature: syncing a branch whose parent was shipped earlier
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME | FILE CONTENT   |
+      | parent | local, origin | parent commit | file      | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME | FILE CONTENT  |
+      | child  | local, origin | child commit | file      | child content |
+    And the current branch is "child"
+    And I ran "git-town ship parent -m 'parent done'"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                          |
+      | child  | git fetch --prune --tags                         |
+      |        | git checkout main                                |
+      | main   | git rebase origin/main                           |
+      |        | git checkout child                               |
+      | child  | git rebase --onto main {{ sha 'parent commit' }} |
+      |        | git push --force-with-lease --force-if-includes  |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | parent done   |
+      | child  | local, origin | parent done   |
+      |        |               | child commit  |
+      | parent | origin        | parent commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                              |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }} |
+      |        | git push --force-with-lease --force-if-includes      |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | parent done   |
+      | child  | local, origin | parent commit |
+      |        |               | child commit  |
+      | parent | origin        | parent commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
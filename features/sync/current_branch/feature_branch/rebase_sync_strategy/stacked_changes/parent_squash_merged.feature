@@ -0,0 +1,55 @@
+Feature: syncing a branch whose parent was shipped using a squash merge
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME | FILE CONTENT   |
+      | parent | local, origin | parent commit | file      | parent content |
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME | FILE CONTENT  |
+      | child  | local, origin | child commit | file      | child content |
+    And origin ships the "parent" branch using a squash merge
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                                     |
+      | child  | git fetch --prune --tags                                    |
+      |        | git checkout main                                           |
+      | main   | git rebase origin/main                                      |
+      |        | git checkout parent                                         |
+      | parent | git rebase main                                             |
+      |        | git checkout main                                           |
+      | main   | git branch -D parent                                        |
+      |        | git checkout child                                          |
+      | child  | git rebase --onto main {{ sha-before-run 'parent commit' }} |
+      |        | git push --force-with-lease --force-if-includes             |
+    And it prints:
+      """
+      deleted branch "parent"
+      """
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | parent       |
+      | child  | local, origin | parent       |
+      |        |               | child commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }}   |
+      |        | git push --force-with-lease --force-if-includes        |
+      |        | git checkout main                                      |
+      | main   | git reset --hard {{ sha 'initial commit' }}            |
+      |        | git branch parent {{ sha-before-run 'parent commit' }} |
+      |        | git checkout child                                     |
+    And the current branch is still "child"
+    And the initial branches and lineage exist
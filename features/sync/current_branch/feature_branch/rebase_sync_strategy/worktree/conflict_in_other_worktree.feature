@@ -0,0 +1,73 @@
+Feature: sync a branch whose parent is active in another worktree and has a merge conflict
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE                   | FILE NAME        | FILE CONTENT   |
+      | main   | origin        | conflicting main commit   | conflicting_file | main content   |
+      | parent | local, origin | conflicting parent commit | conflicting_file | parent content |
+      | child  | local, origin | child commit              | child_file       | child content  |
+    And branch "parent" is active in another worktree
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                  |
+      | child  | git fetch --prune --tags |
+      |        | git checkout main        |
+      | main   | git rebase origin/main   |
+      | parent | git rebase main          |
+    And it prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And it does not print "git town skip"
+    And the current branch is now "main"
+    And the current branch in the other worktree is still "parent"
+    And a rebase is now in progress in the other worktree
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | parent | git rebase --abort                          |
+      | main   | git reset --hard {{ sha 'initial commit' }} |
+      |        | git checkout child                          |
+    And the current branch is now "child"
+    And the current branch in the other worktree is still "parent"
+    And no rebase is in progress in the other worktree
+    And these commits exist now
+      | BRANCH | LOCATION         | MESSAGE                   |
+      | main   | origin           | conflicting main commit   |
+      | child  | local, origin    | child commit              |
+      | parent | origin, worktree | conflicting parent commit |
+
+  Scenario: continue with unresolved conflict
+    When I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND               |
+      | parent | git rebase --continue |
+    And it prints the error:
+      """
+      You must edit all merge conflicts and then
+      mark them as resolved using git add
+      """
+    And a rebase is now in progress in the other worktree
+
+  Scenario: resolve, commit, and continue
+    When I resolve the conflict in "conflicting_file" in the other worktree
+    And I run "git rebase --continue" in the other worktree and enter "resolved commit" for the commit message
+    And I run "git-town continue"
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | parent | git push --force-with-lease --force-if-includes |
+      | main   | git checkout child                              |
+      | child  | git rebase parent                               |
+      |        | git push --force-with-lease --force-if-includes |
+    And the current branch is now "child"
+    And the current branch in the other worktree is still "parent"
+    And no rebase is in progress in the other worktree
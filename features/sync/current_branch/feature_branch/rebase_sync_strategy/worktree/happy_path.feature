@@ -21,8 +21,12 @@ Feature: sync a branch whose parent is active in another worktree
       |        | git checkout main                               |
       | main   | git rebase origin/main                          |
       |        | git push                                        |
-      |        | git checkout child                              |
-      | child  | git rebase origin/parent                        |
+      | parent | git rebase main                                 |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git rebase origin/parent                        |
+      |        | git push --force-with-lease --force-if-includes |
+      | main   | git checkout child                              |
+      | child  | git rebase parent                               |
       |        | git push --force-with-lease --force-if-includes |
       |        | git rebase origin/child                         |
       |        | git push --force-with-lease --force-if-includes |
@@ -33,9 +37,14 @@ Feature: sync a branch whose parent is active in another worktree
       |        |                         | local main commit    |
       | child  | local, origin           | origin child commit  |
       |        |                         | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
       |        |                         | local child commit   |
-      | parent | origin                  | origin parent commit |
-      |        | worktree                | local parent commit  |
+      | parent | origin, worktree        | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
 
   Scenario: undo
     When I run "git-town undo"
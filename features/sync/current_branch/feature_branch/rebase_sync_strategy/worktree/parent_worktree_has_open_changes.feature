@@ -0,0 +1,55 @@
+Feature: sync a branch whose parent is active in another worktree that has uncommitted changes
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "parent"
+    And a feature branch "child" as a child of "parent"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE              |
+      | main   | origin   | origin main commit   |
+      | parent | local    | local parent commit  |
+      |        | origin   | origin parent commit |
+      | child  | local    | local child commit   |
+    And branch "parent" is active in another worktree
+    And an uncommitted file in the other worktree
+    And the current branch is "child"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git fetch --prune --tags                        |
+      |        | git checkout main                               |
+      | main   | git rebase origin/main                          |
+      |        | git checkout child                              |
+      | child  | git rebase origin/parent                        |
+      |        | git push --force-with-lease --force-if-includes |
+    And it prints:
+      """
+      did not sync branch "parent" because its worktree at
+      """
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION                | MESSAGE              |
+      | main   | local, origin, worktree | origin main commit   |
+      | child  | local, origin           | origin parent commit |
+      |        |                         | local child commit   |
+      | parent | origin                  | origin parent commit |
+      |        | worktree                | local parent commit  |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                             |
+      | child  | git reset --hard {{ sha 'local child commit' }}                     |
+      |        | git push --force-with-lease origin {{ sha 'initial commit' }}:child |
+      |        | git checkout main                                                   |
+      | main   | git reset --hard {{ sha 'initial commit' }}                         |
+      |        | git checkout child                                                  |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION | MESSAGE              |
+      | main   | origin   | origin main commit   |
+      | child  | local    | local child commit   |
+      | parent | origin   | origin parent commit |
+      |        | worktree | local parent commit  |
@@ -0,0 +1,36 @@
+Feature: sync a branch whose type is defined by a branch type rule
+
+  Background:
+    Given the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [[branches.rules]]
+      branches = "dependabot/*"
+      type = "observed"
+      """
+    And a known remote feature branch "dependabot/npm/lodash"
+    And I ran "git checkout dependabot/npm/lodash"
+    And the current branch is "dependabot/npm/lodash"
+    And the commits
+      | BRANCH                | LOCATION      | MESSAGE       |
+      | main                  | local, origin | main commit   |
+      | dependabot/npm/lodash | local         | local commit  |
+      |                       | origin        | origin commit |
+    When I run "git-town sync"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH                | COMMAND                                 |
+      | dependabot/npm/lodash | git fetch --prune --tags                |
+      |                       | git add -A                              |
+      |                       | git stash                               |
+      |                       | git rebase origin/dependabot/npm/lodash |
+      |                       | git stash pop                           |
+    And the current branch is still "dependabot/npm/lodash"
+    And these commits exist now
+      | BRANCH                | LOCATION      | MESSAGE       |
+      | main                  | local, origin | main commit   |
+      | dependabot/npm/lodash | local, origin | origin commit |
+      |                       | local         | local commit  |
@@ -0,0 +1,37 @@
+Feature: branch types and lineage defined in the config file
+
+  Background:
+    Given the configuration file:
+      """
+      [branches]
+      main = "main"
+      contribution-regex = "^coworker/"
+
+      [lineage]
+      "release-2" = "main"
+      "release-2-hotfixes" = "release-2"
+      """
+    And the current branch is "release-2"
+    And the commits
+      | BRANCH    | LOCATION | MESSAGE          |
+      | release-2 | local    | release-2 commit |
+    And I ran "git checkout -b release-2-hotfixes"
+    And the current branch is "release-2-hotfixes"
+
+  Scenario: sync a branch whose lineage is defined in the config file
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH             | COMMAND                               |
+      | release-2-hotfixes | git fetch --prune --tags              |
+      |                    | git add -A                            |
+      |                    | git stash                             |
+      |                    | git checkout main                     |
+      | main               | git rebase origin/main                |
+      |                    | git checkout release-2                |
+      | release-2          | git merge --no-edit main              |
+      |                    | git push -u origin release-2          |
+      |                    | git checkout release-2-hotfixes       |
+      | release-2-hotfixes | git merge --no-edit release-2         |
+      |                    | git push -u origin release-2-hotfixes |
+      |                    | git stash pop                         |
+    And the current branch is still "release-2-hotfixes"
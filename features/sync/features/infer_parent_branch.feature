@@ -0,0 +1,42 @@
+Feature: infer unknown parent branches from the commit graph
+
+  Background:
+    Given the current branch is "alpha"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      | FILE NAME  |
+      | alpha  | local    | alpha commit | alpha_file |
+    And I ran "git checkout -b beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     | FILE NAME |
+      | beta   | local    | beta commit | beta_file |
+    And the current branch is "beta"
+
+  Scenario: preselect the inferred parent branches
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                 | KEYS  |
+      | parent branch of beta  | enter |
+      | parent branch of alpha | enter |
+    Then it prints:
+      """
+      Selected parent branch for "beta": alpha
+      """
+    And it prints:
+      """
+      Selected parent branch for "alpha": main
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+
+  Scenario: apply the inferred parent branches without asking
+    When I run "git-town sync --yes"
+    Then it prints:
+      """
+      Inferred parent branch for "beta": alpha
+      Inferred parent branch for "alpha": main
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
@@ -0,0 +1,98 @@
+@skipWindows
+Feature: refresh the stack navigation in proposals
+
+  Background:
+    Given a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And the current branch is "beta"
+    And local Git Town setting "sync-proposal-stacks" is "true"
+
+  Scenario: the synced branches change and their proposals contain a stack section
+    Given the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "Body": "my body\n\n<!-- git-town stack start -->\noutdated\n<!-- git-town stack end -->", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                       |
+      | beta   | git fetch --prune --tags                                      |
+      |        | git checkout main                                             |
+      | main   | git rebase origin/main                                        |
+      |        | git checkout alpha                                            |
+      | alpha  | git merge --no-edit origin/alpha                              |
+      |        | git merge --no-edit main                                      |
+      |        | git push                                                      |
+      |        | git checkout beta                                             |
+      | beta   | git merge --no-edit origin/beta                               |
+      |        | git merge --no-edit alpha                                     |
+      |        | git push                                                      |
+      | <none> | Hosting connector: updating description of proposal #1 ... ok |
+      |        | Hosting connector: updating description of proposal #1 ... ok |
+
+  Scenario: the synced branches don't change
+    Given the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "Body": "my body\n\n<!-- git-town stack start -->\noutdated\n<!-- git-town stack end -->", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit alpha        |
+
+  Scenario: the proposals contain no stack section
+    Given the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "Body": "my body", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit alpha        |
+      |        | git push                         |
+
+  Scenario: refreshing proposal stacks is disabled
+    Given local Git Town setting "sync-proposal-stacks" is "false"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | main   | origin   | main commit |
+    And the hosting connector command "review-tool" responds with:
+      """
+      {"Proposal": {"Number": 1, "Target": "main", "Title": "my title", "Body": "my body\n\n<!-- git-town stack start -->\noutdated\n<!-- git-town stack end -->", "MergeWithAPI": true, "URL": "https://review.example.com/proposals/1"}}
+      """
+    When I run "git-town sync"
+    Then it runs the commands
+      | BRANCH | COMMAND                          |
+      | beta   | git fetch --prune --tags         |
+      |        | git checkout main                |
+      | main   | git rebase origin/main           |
+      |        | git checkout alpha               |
+      | alpha  | git merge --no-edit origin/alpha |
+      |        | git merge --no-edit main         |
+      |        | git push                         |
+      |        | git checkout beta                |
+      | beta   | git merge --no-edit origin/beta  |
+      |        | git merge --no-edit alpha        |
+      |        | git push                         |
@@ -22,6 +22,16 @@ Feature: enter a parent branch name when prompted
       | alpha  | main   |
       | beta   | alpha  |
 
+  Scenario: filter the branches
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                 | KEYS        |
+      | parent branch of beta  | / l p enter |
+      | parent branch of alpha | enter       |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+
   Scenario: choose "<none> (make a perennial branch)"
     When I run "git-town sync" and enter into the dialog:
       | DIALOG                | KEYS     |
@@ -42,4 +42,8 @@ Feature: display all executed Git commands
       """
       Ran 25 shell commands.
       """
+    And it prints something like:
+      """
+      Loaded the repo snapshot in \S+ \(local state \S+, fetch \S+, branches \S+\)\.
+      """
     And all branches are now synchronized
@@ -0,0 +1,11 @@
+Feature: does not combine syncing the stack with syncing all branches
+
+  Scenario:
+    Given a feature branch "feature"
+    And the current branch is "feature"
+    When I run "git-town sync --all --stack"
+    Then it runs no commands
+    And it prints the error:
+      """
+      the --all and --stack flags cannot be used together
+      """
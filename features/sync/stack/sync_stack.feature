@@ -0,0 +1,62 @@
+Feature: sync the stack of the current branch
+
+  Background:
+    Given Git Town setting "sync-feature-strategy" is "rebase"
+    And a feature branch "alpha"
+    And a feature branch "beta" as a child of "alpha"
+    And a feature branch "gamma" as a child of "beta"
+    And a feature branch "other"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE      |
+      | main   | origin   | main commit  |
+      | beta   | local    | beta commit  |
+      | other  | local    | other commit |
+    And the current branch is "beta"
+    When I run "git-town sync --stack"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                         |
+      | beta   | git fetch --prune --tags                        |
+      |        | git checkout main                               |
+      | main   | git rebase origin/main                          |
+      |        | git checkout alpha                              |
+      | alpha  | git rebase main                                 |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+      | beta   | git rebase alpha                                |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout gamma                              |
+      | gamma  | git rebase beta                                 |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout beta                               |
+    And the current branch is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | main commit  |
+      | alpha  | local, origin | main commit  |
+      | beta   | local, origin | main commit  |
+      |        |               | beta commit  |
+      | gamma  | local, origin | main commit  |
+      |        |               | beta commit  |
+      | other  | local         | other commit |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                                            |
+      | beta   | git checkout alpha                                                 |
+      | alpha  | git reset --hard {{ sha 'initial commit' }}                        |
+      |        | git push --force-with-lease --force-if-includes                    |
+      |        | git checkout gamma                                                 |
+      | gamma  | git reset --hard {{ sha 'initial commit' }}                        |
+      |        | git push --force-with-lease --force-if-includes                    |
+      |        | git checkout beta                                                  |
+      | beta   | git reset --hard {{ sha-before-run 'beta commit' }}                |
+      |        | git push --force-with-lease origin {{ sha 'initial commit' }}:beta |
+      |        | git checkout main                                                  |
+      | main   | git reset --hard {{ sha 'initial commit' }}                        |
+      |        | git checkout beta                                                  |
+    And the current branch is still "beta"
+    And the initial commits exist
+    And the initial branches and lineage exist
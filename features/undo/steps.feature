@@ -0,0 +1,50 @@
+Feature: undo several commands at once
+
+  Background:
+    Given the current branch is a feature branch "existing"
+    And I ran "git-town hack first"
+    And I ran "git-town hack second"
+
+  Scenario: undo the two most recent commands
+    When I run "git-town undo --steps 2"
+    Then it runs the commands
+      | BRANCH   | COMMAND                |
+      | second   | git checkout first     |
+      | first    | git branch -D second   |
+      |          | git checkout existing  |
+      | existing | git branch -D first    |
+    And the current branch is now "existing"
+    And the initial branches and lineage exist
+
+  Scenario: undo more commands than the history contains
+    When I run "git-town undo --steps 5"
+    Then it runs no commands
+    And it prints the error:
+      """
+      cannot undo 5 commands because the undo history contains only 2
+      """
+
+  Scenario: undo the remaining command afterwards
+    Given I ran "git-town undo"
+    When I run "git-town undo"
+    Then the current branch is now "existing"
+    And the initial branches and lineage exist
+
+  Scenario: undo several commands with uncommitted changes
+    Given an uncommitted file
+    When I run "git-town undo --steps 2"
+    Then it runs the commands
+      | BRANCH   | COMMAND               |
+      | second   | git add -A            |
+      |          | git stash             |
+      |          | git checkout first    |
+      | first    | git branch -D second  |
+      |          | git stash pop         |
+      |          | git add -A            |
+      |          | git stash             |
+      |          | git checkout existing |
+      | existing | git branch -D first   |
+      |          | git stash pop         |
+    And the current branch is now "existing"
+    And the uncommitted file still exists
+    And the initial branches and lineage exist
@@ -0,0 +1,27 @@
+Feature: create the new worktree in the given directory
+
+  Background:
+    Given the current branch is "main"
+    When I run "git-town worktree new ../worktrees/new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git fetch --prune --tags                      |
+      |        | git rebase origin/main                        |
+      |        | git worktree add -b new ../worktrees/new main |
+    And the current branch is still "main"
+    And branch "new" is now checked out in the worktree at "../worktrees/new"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                              |
+      | main   | git worktree remove ../worktrees/new |
+      |        | git branch -D new                    |
+    And the current branch is still "main"
+    And branch "new" is not checked out in any worktree
+    And the initial branches and lineage exist
@@ -0,0 +1,51 @@
+@smoke
+Feature: create a new feature branch in a new worktree
+
+  Background:
+    Given the current branch is a feature branch "existing"
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE         |
+      | main     | origin   | main commit     |
+      | existing | local    | existing commit |
+    And an uncommitted file
+    When I run "git-town worktree new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH   | COMMAND                                       |
+      | existing | git fetch --prune --tags                      |
+      |          | git add -A                                    |
+      |          | git stash                                     |
+      |          | git checkout main                             |
+      | main     | git rebase origin/main                        |
+      |          | git worktree add -b new ../developer-new main |
+      |          | git checkout existing                         |
+      | existing | git stash pop                                 |
+    And the current branch is still "existing"
+    And the uncommitted file still exists
+    And branch "new" is now checked out in the worktree at "../developer-new"
+    And these commits exist now
+      | BRANCH   | LOCATION      | MESSAGE         |
+      | main     | local, origin | main commit     |
+      | existing | local         | existing commit |
+    And this lineage exists now
+      | BRANCH   | PARENT |
+      | existing | main   |
+      | new      | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH   | COMMAND                                     |
+      | existing | git add -A                                  |
+      |          | git stash                                   |
+      |          | git checkout main                           |
+      | main     | git reset --hard {{ sha 'initial commit' }} |
+      |          | git worktree remove ../developer-new        |
+      |          | git branch -D new                           |
+      |          | git checkout existing                       |
+      | existing | git stash pop                               |
+    And the current branch is still "existing"
+    And branch "new" is not checked out in any worktree
+    And the initial commits exist
+    And the initial branches and lineage exist
@@ -0,0 +1,39 @@
+Feature: auto-push the new branch in the new worktree
+
+  Background:
+    Given Git Town setting "push-new-branches" is "true"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE       |
+      | main   | origin   | origin commit |
+    And the current branch is "main"
+    When I run "git-town worktree new"
+
+  Scenario: result
+    Then it runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git fetch --prune --tags                      |
+      |        | git rebase origin/main                        |
+      |        | git worktree add -b new ../developer-new main |
+      | new    | git push -u origin new                        |
+    And the current branch is still "main"
+    And branch "new" is now checked out in the worktree at "../developer-new"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | origin commit |
+      | new    | origin        | origin commit |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then it runs the commands
+      | BRANCH | COMMAND                                     |
+      | main   | git push origin :new                        |
+      |        | git reset --hard {{ sha 'initial commit' }} |
+      |        | git worktree remove ../developer-new        |
+      |        | git branch -D new                           |
+    And the current branch is still "main"
+    And branch "new" is not checked out in any worktree
+    And the initial commits exist
+    And the initial branches and lineage exist
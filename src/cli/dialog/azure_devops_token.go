@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+const (
+	azureDevOpsTokenTitle = `Azure DevOps API token`
+	azureDevOpsTokenHelp  = `
+If you have a personal access token for Azure DevOps,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// AzureDevOpsToken lets the user enter the Azure DevOps personal access token.
+func AzureDevOpsToken(oldValue configdomain.AzureDevOpsToken, inputs components.TestInput) (configdomain.AzureDevOpsToken, bool, error) {
+	token, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          azureDevOpsTokenHelp,
+		Prompt:        "Your Azure DevOps API token: ",
+		TestInput:     inputs,
+		Title:         azureDevOpsTokenTitle,
+	})
+	fmt.Printf(messages.AzureDevOpsToken, components.FormattedSecret(token, aborted))
+	return configdomain.AzureDevOpsToken(token), aborted, err
+}
@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+const (
+	bitbucketAppPasswordTitle = `Bitbucket app password`
+	bitbucketAppPasswordHelp  = `
+If you have an app password or access token for Bitbucket,
+and want to ship branches from the CLI,
+please enter it now.
+
+It's okay to leave this empty.
+
+`
+)
+
+// BitbucketAppPassword lets the user enter the Bitbucket app password.
+func BitbucketAppPassword(oldValue configdomain.BitbucketAppPassword, inputs components.TestInput) (configdomain.BitbucketAppPassword, bool, error) {
+	password, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketAppPasswordHelp,
+		Prompt:        "Your Bitbucket app password: ",
+		TestInput:     inputs,
+		Title:         bitbucketAppPasswordTitle,
+	})
+	fmt.Printf(messages.BitbucketAppPassword, components.FormattedSecret(password, aborted))
+	return configdomain.BitbucketAppPassword(password), aborted, err
+}
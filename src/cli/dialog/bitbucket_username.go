@@ -0,0 +1,34 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+const (
+	bitbucketUsernameTitle = `Bitbucket username`
+	bitbucketUsernameHelp  = `
+If you want to ship branches from the CLI
+using a Bitbucket app password,
+please enter your Bitbucket username now.
+
+Leave this empty if you use an access token.
+
+`
+)
+
+// BitbucketUsername lets the user enter the Bitbucket username.
+func BitbucketUsername(oldValue configdomain.BitbucketUsername, inputs components.TestInput) (configdomain.BitbucketUsername, bool, error) {
+	username, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          bitbucketUsernameHelp,
+		Prompt:        "Your Bitbucket username: ",
+		TestInput:     inputs,
+		Title:         bitbucketUsernameTitle,
+	})
+	fmt.Printf(messages.BitbucketUsername, components.FormattedToken(username, aborted))
+	return configdomain.BitbucketUsername(username), aborted, err
+}
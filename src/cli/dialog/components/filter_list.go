@@ -0,0 +1,142 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// FilterList is a BubbleList whose entries the user can narrow down by typing a filter text.
+// Pressing "/" starts entering the filter text.
+// While the user enters the filter text, the arrow keys keep moving the cursor and enter accepts the selected entry.
+type FilterList[S fmt.Stringer] struct {
+	BubbleList[S]
+	AllEntries []S    // all entries, Entries contains only the ones matching the filter
+	Filter     string // the text that the displayed entries match
+	Filtering  bool   // whether the user is currently entering the filter text
+}
+
+func NewFilterList[S fmt.Stringer](entries []S, cursor int) FilterList[S] {
+	return FilterList[S]{
+		AllEntries: entries,
+		BubbleList: NewBubbleList(entries, cursor),
+		Filter:     "",
+		Filtering:  false,
+	}
+}
+
+// HandleKey handles the keypresses for entering the filter text and the keypresses common to all BubbleLists.
+func (self *FilterList[S]) HandleKey(key tea.KeyMsg) (bool, tea.Cmd) {
+	if self.Filtering {
+		switch key.Type { //nolint:exhaustive
+		case tea.KeyRunes, tea.KeySpace:
+			self.setFilter(self.Filter + string(key.Runes))
+			return true, nil
+		case tea.KeyBackspace:
+			if len(self.Filter) > 0 {
+				runes := []rune(self.Filter)
+				self.setFilter(string(runes[:len(runes)-1]))
+			}
+			return true, nil
+		case tea.KeyEsc:
+			self.Filtering = false
+			self.setFilter("")
+			return true, nil
+		case tea.KeyCtrlC:
+			self.Status = StatusAborted
+			return true, tea.Quit
+		}
+	} else if key.String() == "/" {
+		self.Filtering = true
+		return true, nil
+	}
+	handled, cmd := self.BubbleList.HandleKey(key)
+	if len(self.Entries) == 0 {
+		// the cursor cannot point to an entry
+		self.Cursor = 0
+	}
+	return handled, cmd
+}
+
+// HasSelection indicates whether the user can accept the currently selected entry.
+func (self FilterList[S]) HasSelection() bool {
+	return len(self.Entries) > 0
+}
+
+// SetEntries replaces all entries of this list, keeping the current filter and the selected entry if possible.
+func (self *FilterList[S]) SetEntries(entries []S) {
+	self.AllEntries = entries
+	self.setFilter(self.Filter)
+}
+
+// FilterView provides the line that displays the filter text.
+func (self FilterList[S]) FilterView() string {
+	if !self.Filtering && self.Filter == "" {
+		return ""
+	}
+	s := strings.Builder{}
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Filter)
+	if self.Filtering {
+		s.WriteString(self.Colors.Help.Styled("_"))
+	}
+	if len(self.Entries) == 0 {
+		s.WriteString(self.Colors.Help.Styled("   no matching entries"))
+	}
+	s.WriteString("\n\n")
+	return s.String()
+}
+
+// setFilter updates the filter text to the given value and shows the matching entries,
+// keeping the selected entry if it matches the new filter.
+func (self *FilterList[S]) setFilter(filter string) {
+	selected := ""
+	if self.Cursor >= 0 && self.Cursor < len(self.Entries) {
+		selected = self.Entries[self.Cursor].String()
+	}
+	self.Filter = filter
+	self.Entries = FilterEntries(self.AllEntries, filter)
+	self.Cursor = 0
+	for e, entry := range self.Entries {
+		if entry.String() == selected {
+			self.Cursor = e
+			break
+		}
+	}
+}
+
+// FilterEntries provides the given entries that fuzzy-match the given filter text.
+func FilterEntries[S fmt.Stringer](entries []S, filter string) []S {
+	if filter == "" {
+		return entries
+	}
+	result := make([]S, 0, len(entries))
+	for _, entry := range entries {
+		if FuzzyMatch(entry.String(), filter) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// FuzzyMatch indicates whether the given text contains all characters of the given filter text in the same order.
+// The comparison ignores case.
+func FuzzyMatch(text, filter string) bool {
+	textRunes := []rune(strings.ToLower(text))
+	t := 0
+	for _, filterRune := range strings.ToLower(filter) {
+		if unicode.IsSpace(filterRune) {
+			continue
+		}
+		for t < len(textRunes) && textRunes[t] != filterRune {
+			t++
+		}
+		if t == len(textRunes) {
+			return false
+		}
+		t++
+	}
+	return true
+}
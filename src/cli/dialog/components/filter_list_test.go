@@ -0,0 +1,113 @@
+package components_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestFilterList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FilterEntries", func(t *testing.T) {
+		t.Parallel()
+		entries := gitdomain.NewLocalBranchNames("main", "kg-feature", "kg-fix", "other")
+		t.Run("empty filter", func(t *testing.T) {
+			t.Parallel()
+			have := components.FilterEntries(entries, "")
+			must.Eq(t, entries, have)
+		})
+		t.Run("matching filter", func(t *testing.T) {
+			t.Parallel()
+			have := components.FilterEntries(entries, "kgf")
+			want := gitdomain.NewLocalBranchNames("kg-feature", "kg-fix")
+			must.Eq(t, want, have)
+		})
+		t.Run("no matches", func(t *testing.T) {
+			t.Parallel()
+			have := components.FilterEntries(entries, "zz")
+			must.Len(t, 0, have)
+		})
+	})
+
+	t.Run("FuzzyMatch", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]bool{
+			"":         true,
+			"feature":  true,
+			"ftr":      true,
+			"FTR":      true,
+			"f tr":     true,
+			"rtf":      false,
+			"features": false,
+		}
+		for give, want := range tests {
+			have := components.FuzzyMatch("my-Feature", give)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("HandleKey", func(t *testing.T) {
+		t.Parallel()
+		t.Run("entering a filter", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewFilterList(gitdomain.NewLocalBranchNames("main", "alpha", "beta", "gamma"), 0)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}) //nolint:exhaustruct
+			must.True(t, list.Filtering)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}}) //nolint:exhaustruct
+			must.Eq(t, gitdomain.NewLocalBranchNames("main", "gamma"), list.Entries)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyDown}) //nolint:exhaustruct
+			must.EqOp(t, "gamma", list.SelectedEntry())
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}}) //nolint:exhaustruct
+			must.Eq(t, gitdomain.NewLocalBranchNames("gamma"), list.Entries)
+			must.EqOp(t, "gamma", list.SelectedEntry())
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyBackspace}) //nolint:exhaustruct
+			must.EqOp(t, "m", list.Filter)
+			must.Eq(t, gitdomain.NewLocalBranchNames("main", "gamma"), list.Entries)
+			must.EqOp(t, "gamma", list.SelectedEntry())
+		})
+		t.Run("no matching entries", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewFilterList(gitdomain.NewLocalBranchNames("main", "alpha"), 1)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}) //nolint:exhaustruct
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}}) //nolint:exhaustruct
+			must.False(t, list.HasSelection())
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyUp}) //nolint:exhaustruct
+			must.EqOp(t, 0, list.Cursor)
+		})
+		t.Run("escape removes the filter", func(t *testing.T) {
+			t.Parallel()
+			entries := gitdomain.NewLocalBranchNames("main", "alpha", "beta")
+			list := components.NewFilterList(entries, 0)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}) //nolint:exhaustruct
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}}) //nolint:exhaustruct
+			must.EqOp(t, "beta", list.SelectedEntry())
+			handled, _ := list.HandleKey(tea.KeyMsg{Type: tea.KeyEsc}) //nolint:exhaustruct
+			must.True(t, handled)
+			must.False(t, list.Filtering)
+			must.EqOp(t, "", list.Filter)
+			must.Eq(t, entries, list.Entries)
+			must.EqOp(t, "beta", list.SelectedEntry())
+			must.False(t, list.Aborted())
+		})
+		t.Run("typing letters without filtering uses them as shortcuts", func(t *testing.T) {
+			t.Parallel()
+			list := components.NewFilterList(gitdomain.NewLocalBranchNames("main", "alpha"), 0)
+			list.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}}) //nolint:exhaustruct
+			must.EqOp(t, "", list.Filter)
+			must.EqOp(t, "alpha", list.SelectedEntry())
+		})
+	})
+
+	t.Run("SetEntries", func(t *testing.T) {
+		t.Parallel()
+		list := components.NewFilterList(gitdomain.NewLocalBranchNames("main", "alpha", "beta"), 2)
+		list.Filter = "a"
+		list.SetEntries(gitdomain.NewLocalBranchNames("alpha", "beta", "delta"))
+		must.Eq(t, gitdomain.NewLocalBranchNames("alpha", "beta", "delta"), list.Entries)
+		must.EqOp(t, "beta", list.SelectedEntry())
+	})
+}
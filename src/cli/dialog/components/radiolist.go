@@ -11,10 +11,11 @@ import (
 // how many elements to display in the dialog
 const WindowSize = 9
 
-// RadioList lets the user select a new main branch for this repo.
+// RadioList lets the user select one of the given entries.
+// The user can narrow down the entries by typing a filter text.
 func RadioList[S fmt.Stringer](entries []S, cursor int, title, help string, inputs TestInput) (selected S, aborted bool, err error) { //nolint:ireturn
 	program := tea.NewProgram(radioListModel[S]{
-		BubbleList: NewBubbleList(entries, cursor),
+		FilterList: NewFilterList(entries, cursor),
 		help:       help,
 		title:      title,
 	})
@@ -24,11 +25,14 @@ func RadioList[S fmt.Stringer](entries []S, cursor int, title, help string, inpu
 		return entries[0], false, err
 	}
 	result := dialogResult.(radioListModel[S]) //nolint:forcetypeassert
+	if !result.HasSelection() {
+		return entries[0], result.Aborted(), nil
+	}
 	return result.SelectedEntry(), result.Aborted(), nil
 }
 
 type radioListModel[S fmt.Stringer] struct {
-	BubbleList[S]
+	FilterList[S]
 	help  string // help text to display before the radio list
 	title string // title to display before the help text
 }
@@ -42,9 +46,12 @@ func (self radioListModel[S]) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolin
 	if !isKeyMsg {
 		return self, nil
 	}
-	if handled, cmd := self.BubbleList.HandleKey(keyMsg); handled {
+	if handled, cmd := self.FilterList.HandleKey(keyMsg); handled {
 		return self, cmd
 	}
+	if !self.HasSelection() {
+		return self, nil
+	}
 	if keyMsg.Type == tea.KeyEnter {
 		self.Status = StatusDone
 		return self, tea.Quit
@@ -65,6 +72,7 @@ func (self radioListModel[S]) View() string {
 	s.WriteString(self.Colors.Title.Styled(self.title))
 	s.WriteRune('\n')
 	s.WriteString(self.help)
+	s.WriteString(self.FilterView())
 	window := slice.Window(slice.WindowArgs{
 		CursorPos:    self.Cursor,
 		ElementCount: len(self.Entries),
@@ -106,6 +114,9 @@ func (self radioListModel[S]) View() string {
 	s.WriteString(self.Colors.Help.Styled("-"))
 	s.WriteString(self.Colors.HelpKey.Styled("9"))
 	s.WriteString(self.Colors.Help.Styled(" jump   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
 	// accept
 	s.WriteString(self.Colors.HelpKey.Styled("enter"))
 	s.WriteString(self.Colors.Help.Styled("/"))
@@ -77,6 +77,8 @@ func recognizeTestInput(input string) tea.Msg { //nolint:ireturn
 		return tea.KeyMsg{Type: tea.KeyUp} //nolint:exhaustruct
 	case "esc":
 		return tea.KeyMsg{Type: tea.KeyEsc} //nolint:exhaustruct
+	case "/":
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}} //nolint:exhaustruct
 	case "0":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}} //nolint:exhaustruct
 	case "1":
@@ -105,10 +107,16 @@ func recognizeTestInput(input string) tea.Msg { //nolint:ireturn
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}} //nolint:exhaustruct
 	case "e":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}} //nolint:exhaustruct
+	case "h":
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}} //nolint:exhaustruct
+	case "l":
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}} //nolint:exhaustruct
 	case "n":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}} //nolint:exhaustruct
 	case "o":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}} //nolint:exhaustruct
+	case "p":
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}} //nolint:exhaustruct
 	case "q":
 		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}} //nolint:exhaustruct
 	}
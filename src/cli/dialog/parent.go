@@ -24,9 +24,14 @@ Most of the time this is the main development branch (%v).
 )
 
 // Parent lets the user select the parent branch for the given branch.
+// The dialog preselects the given default parent, or the main branch if no default parent is given.
 func Parent(args ParentArgs) (gitdomain.LocalBranchName, bool, error) {
 	entries := ParentEntries(args)
-	cursor := stringers.IndexOrStart(entries, args.MainBranch)
+	preselected := args.MainBranch
+	if !args.DefaultParent.IsEmpty() {
+		preselected = args.DefaultParent
+	}
+	cursor := stringers.IndexOrStart(entries, preselected)
 	title := fmt.Sprintf(parentBranchTitleTemplate, args.Branch)
 	help := fmt.Sprintf(parentBranchHelpTemplate, args.Branch, args.MainBranch)
 	selection, aborted, err := components.RadioList(entries, cursor, title, help, args.DialogTestInput)
@@ -36,6 +41,7 @@ func Parent(args ParentArgs) (gitdomain.LocalBranchName, bool, error) {
 
 type ParentArgs struct {
 	Branch          gitdomain.LocalBranchName
+	DefaultParent   gitdomain.LocalBranchName
 	DialogTestInput components.TestInput
 	Lineage         configdomain.Lineage
 	LocalBranches   gitdomain.LocalBranchNames
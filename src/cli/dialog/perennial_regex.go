@@ -29,5 +29,5 @@ func PerennialRegex(oldValue configdomain.PerennialRegex, inputs components.Test
 		Title:         perennialRegexTitle,
 	})
 	fmt.Printf(messages.PerennialRegex, components.FormattedSelection(value, aborted))
-	return configdomain.PerennialRegex(value), aborted, err
+	return configdomain.NewPerennialRegex(value), aborted, err
 }
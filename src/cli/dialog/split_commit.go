@@ -0,0 +1,49 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+const (
+	splitCommitTitleTemplate = `Split branch %s`
+	splitCommitHelpTemplate  = `
+Please select the last commit that should move into the new branch %q.
+This commit and all commits before it go into the new branch,
+all commits after it remain in branch %q.
+
+
+`
+)
+
+// SplitCommit lets the user select the last commit of the given branch that goes into the new parent branch.
+func SplitCommit(branch, newBranch gitdomain.LocalBranchName, commits gitdomain.Commits, dialogTestInput components.TestInput) (gitdomain.Commit, bool, error) {
+	entries := SplitCommitEntries(commits)
+	title := fmt.Sprintf(splitCommitTitleTemplate, branch)
+	help := fmt.Sprintf(splitCommitHelpTemplate, newBranch, branch)
+	selection, aborted, err := components.RadioList(entries, 0, title, help, dialogTestInput)
+	fmt.Printf(messages.SplitCommitSelected, components.FormattedSelection(selection.String(), aborted))
+	return gitdomain.Commit(selection), aborted, err
+}
+
+// SplitCommitEntries provides the commits at which the user can split a branch with the given commits.
+// The last commit is not an option because the split branch must keep at least one commit.
+func SplitCommitEntries(commits gitdomain.Commits) []SplitCommitEntry {
+	if len(commits) == 0 {
+		return []SplitCommitEntry{}
+	}
+	result := make([]SplitCommitEntry, len(commits)-1)
+	for c, commit := range commits[:len(commits)-1] {
+		result[c] = SplitCommitEntry(commit)
+	}
+	return result
+}
+
+type SplitCommitEntry gitdomain.Commit
+
+func (self SplitCommitEntry) String() string {
+	return fmt.Sprintf("%s %s", self.SHA.TruncateTo(7), self.Message)
+}
@@ -0,0 +1,42 @@
+package dialog_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSplitCommit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SplitCommitEntries", func(t *testing.T) {
+		t.Parallel()
+		t.Run("omits the last commit", func(t *testing.T) {
+			t.Parallel()
+			commits := gitdomain.Commits{
+				{Message: "commit 1", SHA: gitdomain.NewSHA("1111111111")},
+				{Message: "commit 2", SHA: gitdomain.NewSHA("2222222222")},
+				{Message: "commit 3", SHA: gitdomain.NewSHA("3333333333")},
+			}
+			have := dialog.SplitCommitEntries(commits)
+			want := []dialog.SplitCommitEntry{
+				{Message: "commit 1", SHA: gitdomain.NewSHA("1111111111")},
+				{Message: "commit 2", SHA: gitdomain.NewSHA("2222222222")},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("no commits", func(t *testing.T) {
+			t.Parallel()
+			have := dialog.SplitCommitEntries(gitdomain.Commits{})
+			must.Len(t, 0, have)
+		})
+	})
+
+	t.Run("SplitCommitEntry.String", func(t *testing.T) {
+		t.Parallel()
+		entry := dialog.SplitCommitEntry{Message: "commit 1", SHA: gitdomain.NewSHA("1234567890")}
+		must.EqOp(t, "1234567 commit 1", entry.String())
+	})
+}
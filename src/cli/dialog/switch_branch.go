@@ -1,6 +1,7 @@
 package dialog
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 
@@ -9,28 +10,55 @@ import (
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/gohacks/slice"
+	"github.com/git-town/git-town/v13/src/messages"
 	"golang.org/x/exp/maps"
 )
 
-func SwitchBranch(localBranches gitdomain.LocalBranchNames, initialBranch gitdomain.LocalBranchName, lineage configdomain.Lineage) (gitdomain.LocalBranchName, bool, error) {
-	entries := SwitchBranchEntries(localBranches, lineage)
-	cursor := SwitchBranchCursorPos(entries, initialBranch)
-	dialogProcess := tea.NewProgram(SwitchModel{
-		BubbleList:       components.NewBubbleList(entries, cursor),
-		InitialBranchPos: cursor,
-	})
+// how many commits the preview of the selected branch displays
+const switchPreviewCommits = 5
+
+// SwitchBranch lets the user select a local branch to check out.
+func SwitchBranch(args SwitchBranchArgs) (gitdomain.LocalBranchName, bool, error) {
+	entries := SwitchBranchEntries(args.Branches, args.Config)
+	cursor := SwitchBranchCursorPos(entries, args.InitialBranch)
+	model := SwitchModel{
+		AllBranches:   entries,
+		FilterList:    components.NewFilterList(entries, cursor),
+		HideTypes:     false,
+		InitialBranch: args.InitialBranch,
+		Lineage:       args.Config.Lineage,
+		LoadCommits:   args.LoadCommits,
+		Previews:      map[gitdomain.LocalBranchName]string{},
+	}
+	model.loadPreview()
+	dialogProcess := tea.NewProgram(model)
 	dialogResult, err := dialogProcess.Run()
 	if err != nil {
 		return "", false, err
 	}
 	result := dialogResult.(SwitchModel) //nolint:forcetypeassert
-	selectedEntry := result.BubbleList.SelectedEntry()
+	if !result.HasSelection() {
+		return args.InitialBranch, result.Aborted(), nil
+	}
+	selectedEntry := result.SelectedEntry()
 	return selectedEntry.Branch, result.Aborted(), nil
 }
 
+type SwitchBranchArgs struct {
+	Branches      gitdomain.BranchInfos
+	Config        *configdomain.FullConfig
+	InitialBranch gitdomain.LocalBranchName
+	LoadCommits   func(branch, parent gitdomain.LocalBranchName) (gitdomain.Commits, error) // provides the commits that the given branch has and its parent doesn't have
+}
+
 type SwitchModel struct {
-	components.BubbleList[SwitchBranchEntry]
-	InitialBranchPos int // position of the currently checked out branch in the list
+	components.FilterList[SwitchBranchEntry]
+	AllBranches   []SwitchBranchEntry                                                       // all entries, including the ones with hidden branch types
+	HideTypes     bool                                                                      // whether to hide observed, parked, and contribution branches
+	InitialBranch gitdomain.LocalBranchName                                                 // the currently checked out branch
+	Lineage       configdomain.Lineage                                                      // parent branches for the preview
+	LoadCommits   func(branch, parent gitdomain.LocalBranchName) (gitdomain.Commits, error) // provides the commits for the preview, nil if the dialog should not display a preview
+	Previews      map[gitdomain.LocalBranchName]string                                      // cached previews of the branches the user has selected so far
 }
 
 func (self SwitchModel) Init() tea.Cmd {
@@ -42,9 +70,19 @@ func (self SwitchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:iret
 	if !isKeyMsg {
 		return self, nil
 	}
-	if handled, code := self.BubbleList.HandleKey(keyMsg); handled {
+	if handled, code := self.FilterList.HandleKey(keyMsg); handled {
+		self.loadPreview()
 		return self, code
 	}
+	if keyMsg.String() == "h" {
+		self.HideTypes = !self.HideTypes
+		self.SetEntries(SwitchVisibleEntries(self.AllBranches, self.HideTypes, self.InitialBranch))
+		self.loadPreview()
+		return self, nil
+	}
+	if !self.HasSelection() {
+		return self, nil
+	}
 	if keyMsg.Type == tea.KeyEnter {
 		self.Status = components.StatusDone
 		return self, tea.Quit
@@ -61,23 +99,29 @@ func (self SwitchModel) View() string {
 		return ""
 	}
 	s := strings.Builder{}
+	s.WriteString(self.FilterView())
 	window := slice.Window(slice.WindowArgs{
 		CursorPos:    self.Cursor,
 		ElementCount: len(self.Entries),
 		WindowSize:   components.WindowSize,
 	})
 	for i := window.StartRow; i < window.EndRow; i++ {
-		branch := self.Entries[i]
+		entry := self.Entries[i]
 		switch {
 		case i == self.Cursor:
-			s.WriteString(self.Colors.Selection.Styled("> " + branch.String()))
-		case i == self.InitialBranchPos:
-			s.WriteString(self.Colors.Initial.Styled("* " + branch.String()))
+			s.WriteString(self.Colors.Selection.Styled("> " + entry.String()))
+		case entry.Branch == self.InitialBranch:
+			s.WriteString(self.Colors.Initial.Styled("* " + entry.String()))
+		case entry.OtherWorktree:
+			s.WriteString(self.Dim.Styled("+ " + entry.String()))
 		default:
-			s.WriteString("  " + branch.String())
+			s.WriteString("  " + entry.String())
 		}
 		s.WriteRune('\n')
 	}
+	if self.HasSelection() {
+		s.WriteString(self.Previews[self.SelectedEntry().Branch])
+	}
 	s.WriteString("\n\n  ")
 	// up
 	s.WriteString(self.Colors.HelpKey.Styled("↑"))
@@ -99,6 +143,16 @@ func (self SwitchModel) View() string {
 	s.WriteString(self.Colors.Help.Styled("/"))
 	s.WriteString(self.Colors.HelpKey.Styled("d"))
 	s.WriteString(self.Colors.Help.Styled(" 10 down   "))
+	// filter
+	s.WriteString(self.Colors.HelpKey.Styled("/"))
+	s.WriteString(self.Colors.Help.Styled(" filter   "))
+	// hide
+	s.WriteString(self.Colors.HelpKey.Styled("h"))
+	if self.HideTypes {
+		s.WriteString(self.Colors.Help.Styled(" show all   "))
+	} else {
+		s.WriteString(self.Colors.Help.Styled(" hide observed/parked/contribution   "))
+	}
 	// accept
 	s.WriteString(self.Colors.HelpKey.Styled("enter"))
 	s.WriteString(self.Colors.Help.Styled("/"))
@@ -114,6 +168,49 @@ func (self SwitchModel) View() string {
 	return s.String()
 }
 
+// loadPreview loads the preview for the selected branch if it isn't cached yet.
+func (self SwitchModel) loadPreview() {
+	if self.LoadCommits == nil || self.Previews == nil || !self.HasSelection() {
+		return
+	}
+	branch := self.SelectedEntry().Branch
+	if _, has := self.Previews[branch]; has {
+		return
+	}
+	parent := self.Lineage.Parent(branch)
+	if parent.IsEmpty() {
+		self.Previews[branch] = ""
+		return
+	}
+	commits, err := self.LoadCommits(branch, parent)
+	self.Previews[branch] = SwitchPreview(branch, parent, commits, err)
+}
+
+// SwitchPreview provides the preview of the last commits that the given branch has and its given parent doesn't have.
+func SwitchPreview(branch, parent gitdomain.LocalBranchName, commits gitdomain.Commits, err error) string {
+	s := strings.Builder{}
+	s.WriteString("\n  ")
+	switch {
+	case err != nil:
+		s.WriteString(fmt.Sprintf(messages.SwitchPreviewProblem, branch, err))
+	case len(commits) == 0:
+		s.WriteString(fmt.Sprintf(messages.SwitchPreviewNoCommits, branch, parent))
+	default:
+		s.WriteString(fmt.Sprintf(messages.SwitchPreviewCommits, branch, parent))
+		if len(commits) > switchPreviewCommits {
+			commits = commits[len(commits)-switchPreviewCommits:]
+		}
+		for c := len(commits) - 1; c >= 0; c-- {
+			s.WriteString("\n    ")
+			s.WriteString(commits[c].SHA.TruncateTo(7).String())
+			s.WriteRune(' ')
+			s.WriteString(commits[c].Message.String())
+		}
+	}
+	s.WriteRune('\n')
+	return s.String()
+}
+
 // SwitchBranchCursorPos provides the initial cursor position for the "switch branch" components.
 func SwitchBranchCursorPos(entries []SwitchBranchEntry, initialBranch gitdomain.LocalBranchName) int {
 	for e, entry := range entries {
@@ -125,39 +222,77 @@ func SwitchBranchCursorPos(entries []SwitchBranchEntry, initialBranch gitdomain.
 }
 
 // SwitchBranchEntries provides the entries for the "switch branch" components.
-func SwitchBranchEntries(localBranches gitdomain.LocalBranchNames, lineage configdomain.Lineage) []SwitchBranchEntry {
-	entries := make([]SwitchBranchEntry, 0, len(lineage))
-	roots := lineage.Roots()
+func SwitchBranchEntries(branches gitdomain.BranchInfos, config *configdomain.FullConfig) []SwitchBranchEntry {
+	entries := make([]SwitchBranchEntry, 0, len(branches))
+	roots := config.Lineage.Roots()
 	// add all entries from the lineage
 	for _, root := range roots {
-		layoutBranches(&entries, root, "", lineage)
+		layoutBranches(&entries, root, "", branches, config)
 	}
 	// add missing local branches
-	branchesInLineage := maps.Keys(lineage)
-	for _, localBranch := range localBranches {
+	branchesInLineage := maps.Keys(config.Lineage)
+	for _, localBranch := range branches.Names() {
 		if slices.Contains(roots, localBranch) {
 			continue
 		}
 		if slices.Contains(branchesInLineage, localBranch) {
 			continue
 		}
-		entries = append(entries, SwitchBranchEntry{Branch: localBranch, Indentation: ""})
+		entries = append(entries, newSwitchBranchEntry(localBranch, "", branches, config))
 	}
 	return entries
 }
 
+// SwitchVisibleEntries provides the given entries without the observed, parked, and contribution branches if they should be hidden.
+// The initial branch always remains visible.
+func SwitchVisibleEntries(entries []SwitchBranchEntry, hideTypes bool, initialBranch gitdomain.LocalBranchName) []SwitchBranchEntry {
+	if !hideTypes {
+		return entries
+	}
+	result := make([]SwitchBranchEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Branch == initialBranch || !isHideableBranchType(entry.Type) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// isHideableBranchType indicates whether the user can hide branches of the given type in the "switch branch" dialog.
+func isHideableBranchType(branchType configdomain.BranchType) bool {
+	switch branchType {
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		return true
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypePrototypeBranch:
+		return false
+	}
+	panic("unhandled branch type: " + branchType.String())
+}
+
 // layoutBranches adds entries for the given branch and its children to the given entry list.
 // The entries are indented according to their position in the given lineage.
-func layoutBranches(result *[]SwitchBranchEntry, branch gitdomain.LocalBranchName, indentation string, lineage configdomain.Lineage) {
-	*result = append(*result, SwitchBranchEntry{Branch: branch, Indentation: indentation})
-	for _, child := range lineage.Children(branch) {
-		layoutBranches(result, child, indentation+"  ", lineage)
+func layoutBranches(result *[]SwitchBranchEntry, branch gitdomain.LocalBranchName, indentation string, branches gitdomain.BranchInfos, config *configdomain.FullConfig) {
+	*result = append(*result, newSwitchBranchEntry(branch, indentation, branches, config))
+	for _, child := range config.Lineage.Children(branch) {
+		layoutBranches(result, child, indentation+"  ", branches, config)
+	}
+}
+
+func newSwitchBranchEntry(branch gitdomain.LocalBranchName, indentation string, branches gitdomain.BranchInfos, config *configdomain.FullConfig) SwitchBranchEntry {
+	branchInfo := branches.FindByLocalName(branch)
+	return SwitchBranchEntry{
+		Branch:        branch,
+		Indentation:   indentation,
+		OtherWorktree: branchInfo != nil && branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree,
+		Type:          config.BranchType(branch),
 	}
 }
 
 type SwitchBranchEntry struct {
-	Branch      gitdomain.LocalBranchName
-	Indentation string
+	Branch        gitdomain.LocalBranchName
+	Indentation   string
+	OtherWorktree bool // whether the branch is checked out in another worktree
+	Type          configdomain.BranchType
 }
 
 func (sbe SwitchBranchEntry) String() string {
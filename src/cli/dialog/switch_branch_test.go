@@ -1,6 +1,7 @@
 package dialog_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/git-town/git-town/v13/src/cli/dialog"
@@ -18,10 +19,10 @@ func TestSwitchBranch(t *testing.T) {
 		t.Run("initialBranch is in the entry list", func(t *testing.T) {
 			t.Parallel()
 			entries := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: ""},
-				{Branch: "alpha1", Indentation: ""},
-				{Branch: "beta", Indentation: ""},
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "alpha", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "alpha1", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "beta", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
 			}
 			initialBranch := gitdomain.NewLocalBranchName("alpha1")
 			have := dialog.SwitchBranchCursorPos(entries, initialBranch)
@@ -31,9 +32,9 @@ func TestSwitchBranch(t *testing.T) {
 		t.Run("initialBranch is not in the entry list", func(t *testing.T) {
 			t.Parallel()
 			entries := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: ""},
-				{Branch: "beta", Indentation: ""},
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "alpha", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "beta", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
 			}
 			initialBranch := gitdomain.NewLocalBranchName("other")
 			have := dialog.SwitchBranchCursorPos(entries, initialBranch)
@@ -49,16 +50,20 @@ func TestSwitchBranch(t *testing.T) {
 			branchA := gitdomain.NewLocalBranchName("alpha")
 			branchB := gitdomain.NewLocalBranchName("beta")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := switchTestConfig(main, configdomain.Lineage{
 				branchA: main,
 				branchB: main,
+			})
+			branches := gitdomain.BranchInfos{
+				switchTestBranch(branchA, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(branchB, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(main, gitdomain.SyncStatusLocalOnly),
 			}
-			localBranches := gitdomain.LocalBranchNames{branchA, branchB, main}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			have := dialog.SwitchBranchEntries(branches, &config)
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: "  "},
-				{Branch: "beta", Indentation: "  "},
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "alpha", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "beta", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
 			}
 			must.Eq(t, want, have)
 		})
@@ -68,17 +73,23 @@ func TestSwitchBranch(t *testing.T) {
 			branchB := gitdomain.NewLocalBranchName("beta")
 			perennial1 := gitdomain.NewLocalBranchName("perennial-1")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := switchTestConfig(main, configdomain.Lineage{
 				branchA: main,
 				branchB: main,
+			})
+			config.PerennialBranches = gitdomain.LocalBranchNames{perennial1}
+			branches := gitdomain.BranchInfos{
+				switchTestBranch(branchA, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(branchB, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(main, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(perennial1, gitdomain.SyncStatusLocalOnly),
 			}
-			localBranches := gitdomain.LocalBranchNames{branchA, branchB, main, perennial1}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			have := dialog.SwitchBranchEntries(branches, &config)
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "alpha", Indentation: "  "},
-				{Branch: "beta", Indentation: "  "},
-				{Branch: "perennial-1", Indentation: ""},
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "alpha", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "beta", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "perennial-1", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
 			}
 			must.Eq(t, want, have)
 		})
@@ -87,16 +98,128 @@ func TestSwitchBranch(t *testing.T) {
 			child := gitdomain.NewLocalBranchName("child")
 			grandchild := gitdomain.NewLocalBranchName("grandchild")
 			main := gitdomain.NewLocalBranchName("main")
-			lineage := configdomain.Lineage{
+			config := switchTestConfig(main, configdomain.Lineage{
 				child:      main,
 				grandchild: child,
+			})
+			branches := gitdomain.BranchInfos{
+				switchTestBranch(grandchild, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(main, gitdomain.SyncStatusLocalOnly),
 			}
-			localBranches := gitdomain.LocalBranchNames{grandchild, main}
-			have := dialog.SwitchBranchEntries(localBranches, lineage)
+			have := dialog.SwitchBranchEntries(branches, &config)
 			want := []dialog.SwitchBranchEntry{
-				{Branch: "main", Indentation: ""},
-				{Branch: "child", Indentation: "  "},
-				{Branch: "grandchild", Indentation: "    "},
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "child", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "grandchild", Indentation: "    ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("branch types and other worktrees", func(t *testing.T) {
+			t.Parallel()
+			observed := gitdomain.NewLocalBranchName("observed")
+			worktree := gitdomain.NewLocalBranchName("worktree")
+			main := gitdomain.NewLocalBranchName("main")
+			config := switchTestConfig(main, configdomain.Lineage{
+				worktree: main,
+			})
+			config.ObservedBranches = gitdomain.LocalBranchNames{observed}
+			branches := gitdomain.BranchInfos{
+				switchTestBranch(main, gitdomain.SyncStatusLocalOnly),
+				switchTestBranch(observed, gitdomain.SyncStatusUpToDate),
+				switchTestBranch(worktree, gitdomain.SyncStatusOtherWorktree),
+			}
+			have := dialog.SwitchBranchEntries(branches, &config)
+			want := []dialog.SwitchBranchEntry{
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "worktree", Indentation: "  ", OtherWorktree: true, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "observed", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeObservedBranch},
+			}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("SwitchPreview", func(t *testing.T) {
+		t.Parallel()
+		branch := gitdomain.NewLocalBranchName("branch")
+		parent := gitdomain.NewLocalBranchName("main")
+		t.Run("commits", func(t *testing.T) {
+			t.Parallel()
+			commits := gitdomain.Commits{
+				{Message: "commit 1", SHA: gitdomain.NewSHA("1111111111")},
+				{Message: "commit 2", SHA: gitdomain.NewSHA("2222222222")},
+			}
+			have := dialog.SwitchPreview(branch, parent, commits, nil)
+			want := `
+  Commits in branch that aren't in main:
+    2222222 commit 2
+    1111111 commit 1
+`
+			must.EqOp(t, want, have)
+		})
+		t.Run("many commits", func(t *testing.T) {
+			t.Parallel()
+			commits := gitdomain.Commits{
+				{Message: "commit 1", SHA: gitdomain.NewSHA("1111111111")},
+				{Message: "commit 2", SHA: gitdomain.NewSHA("2222222222")},
+				{Message: "commit 3", SHA: gitdomain.NewSHA("3333333333")},
+				{Message: "commit 4", SHA: gitdomain.NewSHA("4444444444")},
+				{Message: "commit 5", SHA: gitdomain.NewSHA("5555555555")},
+				{Message: "commit 6", SHA: gitdomain.NewSHA("6666666666")},
+			}
+			have := dialog.SwitchPreview(branch, parent, commits, nil)
+			want := `
+  Commits in branch that aren't in main:
+    6666666 commit 6
+    5555555 commit 5
+    4444444 commit 4
+    3333333 commit 3
+    2222222 commit 2
+`
+			must.EqOp(t, want, have)
+		})
+		t.Run("no commits", func(t *testing.T) {
+			t.Parallel()
+			have := dialog.SwitchPreview(branch, parent, gitdomain.Commits{}, nil)
+			want := `
+  No commits in branch that aren't in main.
+`
+			must.EqOp(t, want, have)
+		})
+		t.Run("error", func(t *testing.T) {
+			t.Parallel()
+			have := dialog.SwitchPreview(branch, parent, gitdomain.Commits{}, errors.New("boom"))
+			want := `
+  cannot load the commits of branch "branch": boom
+`
+			must.EqOp(t, want, have)
+		})
+	})
+
+	t.Run("SwitchVisibleEntries", func(t *testing.T) {
+		t.Parallel()
+		entries := []dialog.SwitchBranchEntry{
+			{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+			{Branch: "feature", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+			{Branch: "parked", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeParkedBranch},
+			{Branch: "contribution", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeContributionBranch},
+			{Branch: "observed-1", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeObservedBranch},
+			{Branch: "observed-2", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeObservedBranch},
+			{Branch: "perennial", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
+		}
+		initialBranch := gitdomain.NewLocalBranchName("observed-2")
+		t.Run("show all", func(t *testing.T) {
+			t.Parallel()
+			have := dialog.SwitchVisibleEntries(entries, false, initialBranch)
+			must.Eq(t, entries, have)
+		})
+		t.Run("hide observed, parked, and contribution branches", func(t *testing.T) {
+			t.Parallel()
+			have := dialog.SwitchVisibleEntries(entries, true, initialBranch)
+			want := []dialog.SwitchBranchEntry{
+				{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				{Branch: "feature", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				{Branch: "observed-2", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeObservedBranch},
+				{Branch: "perennial", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
 			}
 			must.Eq(t, want, have)
 		})
@@ -105,39 +228,25 @@ func TestSwitchBranch(t *testing.T) {
 	t.Run("View", func(t *testing.T) {
 		t.Run("only the main branch exists", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.SwitchModel{
-				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
-					Cursor:       0,
-					Entries:      []dialog.SwitchBranchEntry{{Branch: "main", Indentation: ""}},
-					MaxDigits:    1,
-					NumberFormat: "%d",
-				},
-				InitialBranchPos: 0,
-			}
+			model := switchTestModel(
+				dialog.SwitchBranchEntry{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+			)
 			have := model.View()
 			want := `
 > main
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   h hide observed/parked/contribution   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 
 		t.Run("multiple top-level branches", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.SwitchModel{
-				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
-					Cursor: 0,
-					Entries: []dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: ""},
-						{Branch: "one", Indentation: ""},
-						{Branch: "two", Indentation: ""},
-					},
-					MaxDigits:    1,
-					NumberFormat: "%d",
-				},
-				InitialBranchPos: 0,
-			}
+			model := switchTestModel(
+				dialog.SwitchBranchEntry{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				dialog.SwitchBranchEntry{Branch: "one", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
+				dialog.SwitchBranchEntry{Branch: "two", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
+			)
 			have := model.View()
 			want := `
 > main
@@ -145,29 +254,21 @@ func TestSwitchBranch(t *testing.T) {
   two
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   h hide observed/parked/contribution   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 
 		t.Run("stacked changes", func(t *testing.T) {
 			t.Parallel()
-			model := dialog.SwitchModel{
-				BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
-					Cursor: 0,
-					Entries: []dialog.SwitchBranchEntry{
-						{Branch: "main", Indentation: ""},
-						{Branch: "alpha", Indentation: "  "},
-						{Branch: "alpha1", Indentation: "    "},
-						{Branch: "alpha2", Indentation: "    "},
-						{Branch: "beta", Indentation: "  "},
-						{Branch: "beta1", Indentation: "    "},
-						{Branch: "other", Indentation: ""},
-					},
-					MaxDigits:    1,
-					NumberFormat: "%d",
-				},
-				InitialBranchPos: 0,
-			}
+			model := switchTestModel(
+				dialog.SwitchBranchEntry{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				dialog.SwitchBranchEntry{Branch: "alpha", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "alpha1", Indentation: "    ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "alpha2", Indentation: "    ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "beta", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "beta1", Indentation: "    ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "other", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypePerennialBranch},
+			)
 			have := model.View()
 			want := `
 > main
@@ -179,8 +280,75 @@ func TestSwitchBranch(t *testing.T) {
   other
 
 
-  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   enter/o accept   q/esc/ctrl-c abort`[1:]
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   h hide observed/parked/contribution   enter/o accept   q/esc/ctrl-c abort`[1:]
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("filter and preview", func(t *testing.T) {
+			t.Parallel()
+			model := switchTestModel(
+				dialog.SwitchBranchEntry{Branch: "main", Indentation: "", OtherWorktree: false, Type: configdomain.BranchTypeMainBranch},
+				dialog.SwitchBranchEntry{Branch: "alpha", Indentation: "  ", OtherWorktree: false, Type: configdomain.BranchTypeFeatureBranch},
+				dialog.SwitchBranchEntry{Branch: "beta", Indentation: "  ", OtherWorktree: true, Type: configdomain.BranchTypeFeatureBranch},
+			)
+			model.Filter = "a"
+			model.Entries = model.AllBranches
+			model.Cursor = 1
+			model.Previews = map[gitdomain.LocalBranchName]string{
+				"alpha": "\n  alpha preview\n",
+			}
+			have := model.View()
+			want := `
+/a
+
+* main
+>   alpha
++   beta
+
+  alpha preview
+
+
+  ↑/k up   ↓/j down   ←/u 10 up   →/d 10 down   / filter   h hide observed/parked/contribution   enter/o accept   q/esc/ctrl-c abort`[1:]
 			must.EqOp(t, want, have)
 		})
 	})
 }
+
+// switchTestBranch provides a BranchInfo for a local branch with the given name and sync status.
+func switchTestBranch(name gitdomain.LocalBranchName, syncStatus gitdomain.SyncStatus) gitdomain.BranchInfo {
+	return gitdomain.BranchInfo{
+		LocalName:  name,
+		LocalSHA:   gitdomain.NewSHA("111111"),
+		RemoteName: gitdomain.EmptyRemoteBranchName(),
+		RemoteSHA:  gitdomain.EmptySHA(),
+		SyncStatus: syncStatus,
+	}
+}
+
+// switchTestConfig provides a configuration with the given main branch and lineage.
+func switchTestConfig(main gitdomain.LocalBranchName, lineage configdomain.Lineage) configdomain.FullConfig {
+	config := configdomain.DefaultConfig()
+	config.MainBranch = main
+	config.Lineage = lineage
+	return config
+}
+
+// switchTestModel provides a SwitchModel that displays the given entries, with the first entry being the initial branch.
+func switchTestModel(entries ...dialog.SwitchBranchEntry) dialog.SwitchModel {
+	return dialog.SwitchModel{
+		AllBranches: entries,
+		FilterList: components.FilterList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
+			BubbleList: components.BubbleList[dialog.SwitchBranchEntry]{ //nolint:exhaustruct
+				Cursor:       0,
+				Entries:      entries,
+				MaxDigits:    1,
+				NumberFormat: "%d",
+			},
+		},
+		HideTypes:     false,
+		InitialBranch: entries[0].Branch,
+		Lineage:       configdomain.Lineage{},
+		LoadCommits:   nil,
+		Previews:      nil,
+	}
+}
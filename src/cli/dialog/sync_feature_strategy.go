@@ -22,14 +22,16 @@ hence their name.
 )
 
 const (
-	syncFeatureStrategyEntryMerge  syncFeatureStrategyEntry = `merge updates from the parent branch into feature branches`
-	syncFeatureStrategyEntryRebase syncFeatureStrategyEntry = `rebase feature branches against their parent branch`
+	syncFeatureStrategyEntryMerge    syncFeatureStrategyEntry = `merge updates from the parent branch into feature branches`
+	syncFeatureStrategyEntryRebase   syncFeatureStrategyEntry = `rebase feature branches against their parent branch`
+	syncFeatureStrategyEntryCompress syncFeatureStrategyEntry = `compress feature branches into a single commit on top of their parent branch`
 )
 
 func SyncFeatureStrategy(existing configdomain.SyncFeatureStrategy, inputs components.TestInput) (configdomain.SyncFeatureStrategy, bool, error) {
 	entries := []syncFeatureStrategyEntry{
 		syncFeatureStrategyEntryMerge,
 		syncFeatureStrategyEntryRebase,
+		syncFeatureStrategyEntryCompress,
 	}
 	var defaultPos int
 	switch existing {
@@ -37,6 +39,8 @@ func SyncFeatureStrategy(existing configdomain.SyncFeatureStrategy, inputs compo
 		defaultPos = 0
 	case configdomain.SyncFeatureStrategyRebase:
 		defaultPos = 1
+	case configdomain.SyncFeatureStrategyCompress:
+		defaultPos = 2
 	default:
 		panic("unknown sync-feature-strategy: " + existing.String())
 	}
@@ -61,6 +65,8 @@ func (self syncFeatureStrategyEntry) SyncFeatureStrategy() configdomain.SyncFeat
 		return configdomain.SyncFeatureStrategyMerge
 	case syncFeatureStrategyEntryRebase:
 		return configdomain.SyncFeatureStrategyRebase
+	case syncFeatureStrategyEntryCompress:
+		return configdomain.SyncFeatureStrategyCompress
 	}
 	panic("unhandled syncFeatureStrategyEntry: " + self)
 }
@@ -0,0 +1,30 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Int provides mistake-safe access to integer Cobra command-line flags.
+func Int(name, short string, defaultValue int, desc string, persistent FlagType) (AddFunc, ReadIntFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		switch persistent {
+		case FlagTypePersistent:
+			cmd.PersistentFlags().IntP(name, short, defaultValue, desc)
+		case FlagTypeNonPersistent:
+			cmd.Flags().IntP(name, short, defaultValue, desc)
+		}
+	}
+	readFlag := func(cmd *cobra.Command) int {
+		value, err := cmd.Flags().GetInt(name)
+		if err != nil {
+			panic(fmt.Sprintf("command %q does not have an integer %q flag", cmd.Name(), name))
+		}
+		return value
+	}
+	return addFlag, readFlag
+}
+
+// ReadIntFlagFunc defines the type signature for helper functions that provide the value an integer CLI flag associated with a Cobra command.
+type ReadIntFlagFunc func(*cobra.Command) int
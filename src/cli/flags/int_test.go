@@ -0,0 +1,43 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestInt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("long version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Int("myflag", "m", 1, "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--myflag", "3"})
+		must.NoError(t, err)
+		must.EqOp(t, 3, readFlag(&cmd))
+	})
+
+	t.Run("short version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Int("myflag", "m", 1, "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"-m", "3"})
+		must.NoError(t, err)
+		must.EqOp(t, 3, readFlag(&cmd))
+	})
+
+	t.Run("default value", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.Int("myflag", "m", 1, "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		must.EqOp(t, 1, readFlag(&cmd))
+	})
+}
@@ -0,0 +1,6 @@
+package flags
+
+// JSON provides mistake-safe access to the "--json" Cobra command-line flag.
+func JSON() (AddFunc, ReadBoolFlagFunc) {
+	return Bool("json", "", "Print the output in machine-readable JSON format", FlagTypeNonPersistent)
+}
@@ -0,0 +1,19 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+	cmd := cobra.Command{}
+	addFlag, readFlag := flags.JSON()
+	addFlag(&cmd)
+	err := cmd.ParseFlags([]string{"--json"})
+	must.NoError(t, err)
+	must.EqOp(t, true, readFlag(&cmd))
+}
@@ -0,0 +1,43 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/shoenig/test/must"
+	"github.com/spf13/cobra"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("long version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"--myflag", "value"})
+		must.NoError(t, err)
+		must.EqOp(t, "value", readFlag(&cmd))
+	})
+
+	t.Run("short version", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypePersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{"-m", "value"})
+		must.NoError(t, err)
+		must.EqOp(t, "value", readFlag(&cmd))
+	})
+
+	t.Run("not given", func(t *testing.T) {
+		t.Parallel()
+		cmd := cobra.Command{}
+		addFlag, readFlag := flags.String("myflag", "m", "desc", flags.FlagTypeNonPersistent)
+		addFlag(&cmd)
+		err := cmd.ParseFlags([]string{})
+		must.NoError(t, err)
+		must.EqOp(t, "", readFlag(&cmd))
+	})
+}
@@ -0,0 +1,6 @@
+package flags
+
+// Yes provides mistake-safe access to the "--yes" Cobra command-line flag.
+func Yes() (AddFunc, ReadBoolFlagFunc) {
+	return Bool("yes", "y", "Apply inferred parent branches without asking", FlagTypeNonPersistent)
+}
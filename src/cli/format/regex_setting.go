@@ -0,0 +1,10 @@
+package format
+
+// RegexSetting provides a printable version of the given regular expression configuration value,
+// including the given problem with it if there is one.
+func RegexSetting(text string, problem error) string {
+	if problem != nil {
+		return text + " (ignored because it is invalid)"
+	}
+	return StringSetting(text)
+}
@@ -0,0 +1,71 @@
+package jsonoutput
+
+import (
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+)
+
+// Branch describes a branch in the lineage tree.
+type Branch struct {
+	// the name of the branch
+	Name gitdomain.LocalBranchName `json:"name"`
+
+	// the parent of the branch, empty for branches without a parent
+	Parent gitdomain.LocalBranchName `json:"parent,omitempty"`
+
+	// the SHA of the local branch, empty if the branch doesn't exist locally
+	LocalSHA gitdomain.SHA `json:"localSHA,omitempty"`
+
+	// the name of the tracking branch, empty if the branch has none
+	RemoteName gitdomain.RemoteBranchName `json:"remoteName,omitempty"`
+
+	// the SHA of the tracking branch, empty if the branch has none
+	RemoteSHA gitdomain.SHA `json:"remoteSHA,omitempty"`
+
+	// how the local branch relates to its tracking branch
+	SyncStatus gitdomain.SyncStatus `json:"syncStatus"`
+
+	// the sync-feature strategy that applies to this branch, empty for branches that don't use one
+	SyncStrategy configdomain.SyncFeatureStrategy `json:"syncStrategy,omitempty"`
+
+	// the type of the branch
+	Type string `json:"type"`
+}
+
+// Branches provides the local branches in the order of the lineage tree:
+// each root followed by its descendants, depth-first,
+// and then the branches that aren't part of the lineage.
+func Branches(config *configdomain.FullConfig, branches gitdomain.BranchInfos) []Branch {
+	names := gitdomain.LocalBranchNames{}
+	for _, root := range config.Lineage.Roots() {
+		names = append(names, config.Lineage.BranchAndDescendants(root)...)
+	}
+	for _, branch := range branches.LocalBranches().Names() {
+		if !names.Contains(branch) {
+			names = append(names, branch)
+		}
+	}
+	result := []Branch{}
+	for _, name := range names {
+		branchInfo := branches.FindByLocalName(name)
+		if branchInfo == nil {
+			continue
+		}
+		branchType := config.BranchType(name)
+		var syncStrategy configdomain.SyncFeatureStrategy
+		if branchType == configdomain.BranchTypeFeatureBranch || branchType == configdomain.BranchTypeParkedBranch || branchType == configdomain.BranchTypePrototypeBranch {
+			syncStrategy = config.SyncFeatureStrategyFor(name)
+		}
+		result = append(result, Branch{
+			LocalSHA:     branchInfo.LocalSHA,
+			Name:         name,
+			Parent:       config.Lineage.Parent(name),
+			RemoteName:   branchInfo.RemoteName,
+			RemoteSHA:    branchInfo.RemoteSHA,
+			SyncStatus:   branchInfo.SyncStatus,
+			SyncStrategy: syncStrategy,
+			Type:         branchType.Name(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package jsonoutput_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/jsonoutput"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranches(t *testing.T) {
+	t.Parallel()
+	main := gitdomain.NewLocalBranchName("main")
+	alpha := gitdomain.NewLocalBranchName("alpha")
+	beta := gitdomain.NewLocalBranchName("beta")
+	gamma := gitdomain.NewLocalBranchName("gamma")
+	qa := gitdomain.NewLocalBranchName("qa")
+	config := configdomain.FullConfig{ //nolint:exhaustruct
+		Lineage: configdomain.Lineage{
+			alpha: main,
+			beta:  alpha,
+			gamma: main,
+		},
+		MainBranch:          main,
+		PerennialBranches:   gitdomain.LocalBranchNames{qa},
+		SyncFeatureStrategy: configdomain.SyncFeatureStrategyMerge,
+		SyncStrategyOverrides: configdomain.SyncStrategyOverrides{
+			beta: configdomain.SyncFeatureStrategyRebase,
+		},
+	}
+	branches := gitdomain.BranchInfos{
+		{LocalName: alpha, LocalSHA: "111111", RemoteName: "origin/alpha", RemoteSHA: "222222", SyncStatus: gitdomain.SyncStatusNotInSync},
+		{LocalName: beta, LocalSHA: "333333", RemoteName: "", RemoteSHA: "", SyncStatus: gitdomain.SyncStatusLocalOnly},
+		{LocalName: main, LocalSHA: "444444", RemoteName: "origin/main", RemoteSHA: "444444", SyncStatus: gitdomain.SyncStatusUpToDate},
+		{LocalName: qa, LocalSHA: "555555", RemoteName: "origin/qa", RemoteSHA: "555555", SyncStatus: gitdomain.SyncStatusUpToDate},
+	}
+	have := jsonoutput.Branches(&config, branches)
+	want := []jsonoutput.Branch{
+		{Name: main, Parent: "", LocalSHA: "444444", RemoteName: "origin/main", RemoteSHA: "444444", SyncStatus: gitdomain.SyncStatusUpToDate, SyncStrategy: "", Type: "main"},
+		{Name: alpha, Parent: main, LocalSHA: "111111", RemoteName: "origin/alpha", RemoteSHA: "222222", SyncStatus: gitdomain.SyncStatusNotInSync, SyncStrategy: configdomain.SyncFeatureStrategyMerge, Type: "feature"},
+		{Name: beta, Parent: alpha, LocalSHA: "333333", RemoteName: "", RemoteSHA: "", SyncStatus: gitdomain.SyncStatusLocalOnly, SyncStrategy: configdomain.SyncFeatureStrategyRebase, Type: "feature"},
+		{Name: qa, Parent: "", LocalSHA: "555555", RemoteName: "origin/qa", RemoteSHA: "555555", SyncStatus: gitdomain.SyncStatusUpToDate, SyncStrategy: "", Type: "perennial"},
+	}
+	must.Eq(t, want, have)
+}
@@ -0,0 +1,4 @@
+// Package jsonoutput provides machine-readable versions of the information
+// that Git Town commands display to humans.
+// Editor plugins and scripts consume it via the "--json" flag.
+package jsonoutput
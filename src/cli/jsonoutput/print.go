@@ -0,0 +1,16 @@
+package jsonoutput
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Print prints the given data as indented JSON to STDOUT.
+func Print(data any) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
@@ -0,0 +1,116 @@
+package jsonoutput
+
+import (
+	"github.com/git-town/git-town/v13/src/config"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/config/gitconfig"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+)
+
+const (
+	SourceConfigFile = "config-file"
+	SourceDefault    = "default"
+	SourceGlobal     = "global"
+	SourceLocal      = "local"
+)
+
+// SecretMask replaces the values of API tokens and passwords in the JSON output.
+const SecretMask = "(hidden)"
+
+// Config describes the resolved configuration of a repository.
+type Config struct {
+	// the local branches in the order of the lineage tree
+	Branches []Branch `json:"branches"`
+
+	// the resolved settings, keyed by their Git configuration key
+	Settings map[gitconfig.Key]Setting `json:"settings"`
+}
+
+// Setting describes the resolved value of a configuration setting and where it comes from.
+type Setting struct {
+	// where the value comes from: "local", "global", "config-file", or "default"
+	Source string `json:"source"`
+
+	// the resolved value
+	Value any `json:"value"`
+}
+
+// NewConfig provides the Config for the given configuration and branches.
+func NewConfig(config *config.Config, branches gitdomain.BranchInfos) Config {
+	return Config{
+		Branches: Branches(&config.FullConfig, branches),
+		Settings: Settings(config),
+	}
+}
+
+// Settings provides the resolved settings of the given configuration.
+// Aliases and the lineage are omitted, the lineage is part of the branches.
+func Settings(config *config.Config) map[gitconfig.Key]Setting {
+	full := config.FullConfig
+	file := config.ConfigFile
+	if file == nil {
+		file = &configdomain.PartialConfig{} //nolint:exhaustruct
+	}
+	global := config.GlobalGitConfig
+	local := config.LocalGitConfig
+	return map[gitconfig.Key]Setting{
+		gitconfig.KeyAzureDevOpsToken:         newSecretSetting(full.AzureDevOpsToken, local.AzureDevOpsToken, global.AzureDevOpsToken, file.AzureDevOpsToken),
+		gitconfig.KeyBitbucketAppPassword:     newSecretSetting(full.BitbucketAppPassword, local.BitbucketAppPassword, global.BitbucketAppPassword, file.BitbucketAppPassword),
+		gitconfig.KeyBitbucketUsername:        newSetting(full.BitbucketUsername, local.BitbucketUsername, global.BitbucketUsername, file.BitbucketUsername),
+		gitconfig.KeyBranchTypeRules:          newSetting(full.BranchTypeRules, local.BranchTypeRules, global.BranchTypeRules, file.BranchTypeRules),
+		gitconfig.KeyContributionBranches:     newSetting(full.ContributionBranches, local.ContributionBranches, global.ContributionBranches, file.ContributionBranches),
+		gitconfig.KeyGitUserEmail:             newSetting(full.GitUserEmail, local.GitUserEmail, global.GitUserEmail, file.GitUserEmail),
+		gitconfig.KeyGitUserName:              newSetting(full.GitUserName, local.GitUserName, global.GitUserName, file.GitUserName),
+		gitconfig.KeyGiteaToken:               newSecretSetting(full.GiteaToken, local.GiteaToken, global.GiteaToken, file.GiteaToken),
+		gitconfig.KeyGithubToken:              newSecretSetting(full.GitHubToken, local.GitHubToken, global.GitHubToken, file.GitHubToken),
+		gitconfig.KeyGitlabToken:              newSecretSetting(full.GitLabToken, local.GitLabToken, global.GitLabToken, file.GitLabToken),
+		gitconfig.KeyHostingConnectorCommand:  newSetting(full.HostingConnectorCommand, local.HostingConnectorCommand, global.HostingConnectorCommand, file.HostingConnectorCommand),
+		gitconfig.KeyHostingOriginHostname:    newSetting(full.HostingOriginHostname, local.HostingOriginHostname, global.HostingOriginHostname, file.HostingOriginHostname),
+		gitconfig.KeyHostingPlatform:          newSetting(full.HostingPlatform, local.HostingPlatform, global.HostingPlatform, file.HostingPlatform),
+		gitconfig.KeyMainBranch:               newSetting(full.MainBranch, local.MainBranch, global.MainBranch, file.MainBranch),
+		gitconfig.KeyObservedBranches:         newSetting(full.ObservedBranches, local.ObservedBranches, global.ObservedBranches, file.ObservedBranches),
+		gitconfig.KeyOffline:                  newSetting(full.Offline, local.Offline, global.Offline, file.Offline),
+		gitconfig.KeyParkedBranches:           newSetting(full.ParkedBranches, local.ParkedBranches, global.ParkedBranches, file.ParkedBranches),
+		gitconfig.KeyPerennialBranches:        newSetting(full.PerennialBranches, local.PerennialBranches, global.PerennialBranches, file.PerennialBranches),
+		gitconfig.KeyPerennialRegex:           newSetting(full.PerennialRegex, local.PerennialRegex, global.PerennialRegex, file.PerennialRegex),
+		gitconfig.KeyProposalStackTemplate:    newSetting(full.ProposalStackTemplate, local.ProposalStackTemplate, global.ProposalStackTemplate, file.ProposalStackTemplate),
+		gitconfig.KeyPrototypeBranches:        newSetting(full.PrototypeBranches, local.PrototypeBranches, global.PrototypeBranches, file.PrototypeBranches),
+		gitconfig.KeyPushHook:                 newSetting(full.PushHook, local.PushHook, global.PushHook, file.PushHook),
+		gitconfig.KeyPushNewBranches:          newSetting(full.PushNewBranches, local.PushNewBranches, global.PushNewBranches, file.PushNewBranches),
+		gitconfig.KeyShareLineage:             newSetting(full.ShareLineage, local.ShareLineage, global.ShareLineage, file.ShareLineage),
+		gitconfig.KeyShipDeleteTrackingBranch: newSetting(full.ShipDeleteTrackingBranch, local.ShipDeleteTrackingBranch, global.ShipDeleteTrackingBranch, file.ShipDeleteTrackingBranch),
+		gitconfig.KeySyncBeforeShip:           newSetting(full.SyncBeforeShip, local.SyncBeforeShip, global.SyncBeforeShip, file.SyncBeforeShip),
+		gitconfig.KeySyncFeatureStrategy:      newSetting(full.SyncFeatureStrategy, local.SyncFeatureStrategy, global.SyncFeatureStrategy, file.SyncFeatureStrategy),
+		gitconfig.KeySyncPerennialStrategy:    newSetting(full.SyncPerennialStrategy, local.SyncPerennialStrategy, global.SyncPerennialStrategy, file.SyncPerennialStrategy),
+		gitconfig.KeySyncProposalStacks:       newSetting(full.SyncProposalStacks, local.SyncProposalStacks, global.SyncProposalStacks, file.SyncProposalStacks),
+		gitconfig.KeySyncUpstream:             newSetting(full.SyncUpstream, local.SyncUpstream, global.SyncUpstream, file.SyncUpstream),
+	}
+}
+
+// newSecretSetting provides the Setting for an API token or password
+// without revealing its value.
+func newSecretSetting[T ~string](value T, local, global, file *T) Setting {
+	result := newSetting(value, local, global, file)
+	if value != "" {
+		result.Value = SecretMask
+	}
+	return result
+}
+
+// newSetting provides the Setting with the given resolved value,
+// whose source is the configuration with the highest precedence that contains the value.
+func newSetting[T any](value T, local, global, file *T) Setting {
+	source := SourceDefault
+	switch {
+	case local != nil:
+		source = SourceLocal
+	case global != nil:
+		source = SourceGlobal
+	case file != nil:
+		source = SourceConfigFile
+	}
+	return Setting{
+		Source: source,
+		Value:  value,
+	}
+}
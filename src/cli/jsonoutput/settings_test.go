@@ -0,0 +1,45 @@
+package jsonoutput_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/jsonoutput"
+	"github.com/git-town/git-town/v13/src/config"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/config/gitconfig"
+	"github.com/shoenig/test/must"
+)
+
+func TestSettings(t *testing.T) {
+	t.Parallel()
+	localOffline := configdomain.Offline(false)
+	globalOffline := configdomain.Offline(true)
+	globalPushHook := configdomain.PushHook(false)
+	fileSyncFeatureStrategy := configdomain.SyncFeatureStrategyRebase
+	fullConfig := configdomain.DefaultConfig()
+	fullConfig.Offline = localOffline
+	fullConfig.PushHook = globalPushHook
+	fullConfig.SyncFeatureStrategy = fileSyncFeatureStrategy
+	localGitHubToken := configdomain.GitHubToken("secret-token")
+	fullConfig.GitHubToken = localGitHubToken
+	have := jsonoutput.Settings(&config.Config{ //nolint:exhaustruct
+		ConfigFile: &configdomain.PartialConfig{ //nolint:exhaustruct
+			SyncFeatureStrategy: &fileSyncFeatureStrategy,
+		},
+		FullConfig: fullConfig,
+		GlobalGitConfig: configdomain.PartialConfig{ //nolint:exhaustruct
+			Offline:  &globalOffline,
+			PushHook: &globalPushHook,
+		},
+		LocalGitConfig: configdomain.PartialConfig{ //nolint:exhaustruct
+			GitHubToken: &localGitHubToken,
+			Offline:     &localOffline,
+		},
+	})
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceLocal, Value: localOffline}, have[gitconfig.KeyOffline])
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceGlobal, Value: globalPushHook}, have[gitconfig.KeyPushHook])
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceConfigFile, Value: fileSyncFeatureStrategy}, have[gitconfig.KeySyncFeatureStrategy])
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceDefault, Value: configdomain.SyncPerennialStrategyRebase}, have[gitconfig.KeySyncPerennialStrategy])
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceLocal, Value: jsonoutput.SecretMask}, have[gitconfig.KeyGithubToken])
+	must.Eq(t, jsonoutput.Setting{Source: jsonoutput.SourceDefault, Value: configdomain.GitLabToken("")}, have[gitconfig.KeyGitlabToken])
+}
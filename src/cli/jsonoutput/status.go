@@ -0,0 +1,47 @@
+package jsonoutput
+
+import "github.com/git-town/git-town/v13/src/vm/runstate"
+
+// Status describes the state of the Git Town command that ran last.
+type Status struct {
+	// whether "git town continue" can resume the last command
+	CanContinue bool `json:"canContinue"`
+
+	// whether "git town skip" can skip the current branch of the last command
+	CanSkip bool `json:"canSkip"`
+
+	// whether "git town undo" can undo the last command
+	CanUndo bool `json:"canUndo"`
+
+	// the path of the file that persists the runstate
+	File string `json:"file"`
+
+	// whether the last command ran to completion
+	Finished bool `json:"finished"`
+
+	// the persisted state of the last command, nil if there is none
+	RunState *runstate.RunState `json:"runState"`
+}
+
+// NewStatus provides the Status for the given runstate persisted in the given file.
+func NewStatus(file string, runState *runstate.RunState) Status {
+	result := Status{
+		CanContinue: false,
+		CanSkip:     false,
+		CanUndo:     false,
+		File:        file,
+		Finished:    false,
+		RunState:    runState,
+	}
+	switch {
+	case runState == nil:
+	case runState.IsFinished():
+		result.CanUndo = true
+		result.Finished = true
+	default:
+		result.CanContinue = runState.HasRunProgram()
+		result.CanSkip = runState.UnfinishedDetails.CanSkip
+		result.CanUndo = runState.HasAbortProgram()
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package jsonoutput_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v13/src/cli/jsonoutput"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestNewStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no runstate", func(t *testing.T) {
+		t.Parallel()
+		have := jsonoutput.NewStatus("file.json", nil)
+		want := jsonoutput.Status{
+			CanContinue: false,
+			CanSkip:     false,
+			CanUndo:     false,
+			File:        "file.json",
+			Finished:    false,
+			RunState:    nil,
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("finished runstate", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.EmptyRunState()
+		have := jsonoutput.NewStatus("file.json", &runState)
+		must.False(t, have.CanContinue)
+		must.False(t, have.CanSkip)
+		must.True(t, have.CanUndo)
+		must.True(t, have.Finished)
+	})
+
+	t.Run("unfinished runstate", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.EmptyRunState()
+		runState.RunProgram = program.Program{}
+		runState.RunProgram.Add(&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("main")})
+		runState.UnfinishedDetails = &runstate.UnfinishedRunStateDetails{
+			CanSkip:   true,
+			EndBranch: gitdomain.NewLocalBranchName("feature"),
+			EndTime:   time.Now(),
+		}
+		have := jsonoutput.NewStatus("file.json", &runState)
+		must.True(t, have.CanContinue)
+		must.True(t, have.CanSkip)
+		must.False(t, have.CanUndo)
+		must.False(t, have.Finished)
+	})
+}
@@ -30,6 +30,7 @@ See "sync" for information regarding upstream remotes.`
 func appendCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
 	cmd := cobra.Command{
 		Use:     "append <branch>",
 		GroupID: "lineage",
@@ -37,15 +38,16 @@ func appendCmd() *cobra.Command {
 		Short:   appendDesc,
 		Long:    cmdhelpers.Long(appendDesc, appendHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeAppend(args[0], readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeAppend(args[0], readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func executeAppend(arg string, dryRun, verbose bool) error {
+func executeAppend(arg string, dryRun, verbose, yes bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -57,7 +59,7 @@ func executeAppend(arg string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineAppendConfig(gitdomain.NewLocalBranchName(arg), repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineAppendConfig(gitdomain.NewLocalBranchName(arg), repo, dryRun, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
@@ -102,7 +104,7 @@ type appendConfig struct {
 	targetBranch              gitdomain.LocalBranchName
 }
 
-func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, dryRun, verbose bool) (*appendConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*appendConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	fc := execute.FailureCollector{}
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -131,7 +133,9 @@ func determineAppendConfig(targetBranch gitdomain.LocalBranchName, repo *execute
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
 		Runner:           repo.Runner,
+		Yes:              yes,
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -165,6 +169,7 @@ func appendProgram(config *appendConfig) program.Program {
 			InitialBranch: config.initialBranch,
 			Program:       &prog,
 			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
 			PushBranch:    true,
 		})
 	}
@@ -177,7 +182,7 @@ func appendProgram(config *appendConfig) program.Program {
 		Ancestors: config.newBranchParentCandidates,
 	})
 	prog.Add(&opcodes.Checkout{Branch: config.targetBranch})
-	if config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline() {
+	if config.remotes.HasOrigin() && config.ShouldPushNewBranch(config.targetBranch) && config.IsOnline() {
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
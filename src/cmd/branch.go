@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/validate"
+	"github.com/spf13/cobra"
+)
+
+const branchDesc = "Displays the local branches as a tree"
+
+const branchHelp = `
+Shows the lineage of all local branches. Each branch is annotated with its type, its sync status, how many commits it is ahead of or behind its parent and tracking branch, and when online the proposal for it.`
+
+func branchCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "branch",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   branchDesc,
+		Long:    cmdhelpers.Long(branchDesc, branchHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeBranch(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBranch(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	entries, err := determineBranchEntries(repo)
+	if err != nil {
+		return err
+	}
+	fmt.Print(formatBranchEntries(entries))
+	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), repo.Runner.FinalMessages.Result())
+	return nil
+}
+
+// branchEntry describes a branch displayed by "git town branch".
+type branchEntry struct {
+	aheadOfParent   int                       // how many commits the branch has that its parent doesn't have
+	aheadOfTracking int                       // how many commits the branch has that its tracking branch doesn't have
+	behindParent    int                       // how many commits the parent has that the branch doesn't have
+	behindTracking  int                       // how many commits the tracking branch has that the branch doesn't have
+	branchType      configdomain.BranchType   // the type of the branch
+	current         bool                      // whether the branch is checked out
+	depth           int                       // how many ancestors of the branch are displayed above it
+	info            gitdomain.BranchInfo      // the branch in the snapshot of the repo
+	parent          gitdomain.LocalBranchName // the parent of the branch, empty if it has none
+	proposal        *hostingdomain.Proposal   // the proposal for the branch, nil if there is none or the connector is unavailable
+}
+
+func determineBranchEntries(repo *execute.OpenRepoResult) ([]branchEntry, error) {
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches()
+	dialogInputs := components.LoadTestInputs(os.Environ())
+	err = validate.IsConfigured(&repo.Runner.Backend, &repo.Runner.Config.FullConfig, localBranches.Names(), &dialogInputs)
+	if err != nil {
+		return nil, err
+	}
+	var connector hostingdomain.Connector
+	if !repo.IsOffline {
+		originURL := repo.Runner.Config.OriginURL()
+		if originURL != nil {
+			connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+				FullConfig:      &repo.Runner.Config.FullConfig,
+				HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+				Log:             print.Logger{},
+				OriginURL:       originURL,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	names := gitdomain.LocalBranchNames{}
+	depths := map[gitdomain.LocalBranchName]int{}
+	for _, root := range lineage.Roots() {
+		for _, branch := range lineage.BranchAndDescendants(root) {
+			names = append(names, branch)
+			depths[branch] = len(lineage.Ancestors(branch))
+		}
+	}
+	for _, branch := range localBranches.Names() {
+		if !names.Contains(branch) {
+			names = append(names, branch)
+		}
+	}
+	parentsCounts, err := parentCommitCounts(repo, lineage, localBranches.Names())
+	if err != nil {
+		return nil, err
+	}
+	trackingsCounts, err := repo.Runner.Backend.TrackingCounts()
+	if err != nil {
+		return nil, err
+	}
+	result := []branchEntry{}
+	for _, name := range names {
+		branchInfo := localBranches.FindByLocalName(name)
+		if branchInfo == nil {
+			continue
+		}
+		entry := branchEntry{
+			aheadOfParent:   0,
+			aheadOfTracking: 0,
+			behindParent:    0,
+			behindTracking:  0,
+			branchType:      repo.Runner.Config.FullConfig.BranchType(name),
+			current:         name == branchesSnapshot.Active,
+			depth:           depths[name],
+			info:            *branchInfo,
+			parent:          lineage.Parent(name),
+			proposal:        nil,
+		}
+		parentCounts := parentsCounts[name]
+		entry.aheadOfParent, entry.behindParent = parentCounts.Ahead, parentCounts.Behind
+		if branchInfo.SyncStatus == gitdomain.SyncStatusNotInSync {
+			trackingCounts := trackingsCounts[name]
+			entry.aheadOfTracking, entry.behindTracking = trackingCounts.Ahead, trackingCounts.Behind
+		}
+		if connector != nil && !entry.parent.IsEmpty() {
+			entry.proposal, err = connector.FindProposal(name, entry.parent)
+			if err != nil {
+				repo.Runner.FinalMessages.Add(fmt.Sprintf(messages.BranchProposalProblem, name, err))
+			}
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// parentCommitCounts provides how many commits the given local branches are ahead of and behind their local parent branches.
+// It queries the commit counts for all children of a parent branch at once.
+func parentCommitCounts(repo *execute.OpenRepoResult, lineage configdomain.Lineage, localBranches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	result := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{}
+	for _, parent := range localBranches {
+		children := gitdomain.LocalBranchNames{}
+		for _, child := range lineage.Children(parent) {
+			if localBranches.Contains(child) {
+				children = append(children, child)
+			}
+		}
+		if len(children) == 0 {
+			continue
+		}
+		counts, err := repo.Runner.Backend.CommitCountsAgainst(parent, children)
+		if err != nil {
+			return result, err
+		}
+		for child, count := range counts {
+			result[child] = count
+		}
+	}
+	return result, nil
+}
+
+// formatBranchEntries provides the tree of the given branches, one line per branch.
+func formatBranchEntries(entries []branchEntry) string {
+	result := strings.Builder{}
+	for _, entry := range entries {
+		result.WriteString(strings.Repeat("  ", entry.depth))
+		if entry.current {
+			result.WriteString("* ")
+		} else {
+			result.WriteString("  ")
+		}
+		result.WriteString(entry.info.LocalName.String())
+		result.WriteString("  (")
+		result.WriteString(strings.Join(branchAnnotations(entry), ", "))
+		result.WriteString(")\n")
+	}
+	return result.String()
+}
+
+// branchAnnotations provides the details displayed next to the given branch.
+func branchAnnotations(entry branchEntry) []string {
+	result := []string{}
+	if entry.branchType != configdomain.BranchTypeFeatureBranch {
+		result = append(result, entry.branchType.String())
+	}
+	result = append(result, entry.info.SyncStatus.String())
+	if counts := formatCommitCounts(entry.aheadOfTracking, entry.behindTracking, entry.info.RemoteName.String()); counts != "" {
+		result = append(result, counts)
+	}
+	if counts := formatCommitCounts(entry.aheadOfParent, entry.behindParent, entry.parent.String()); counts != "" {
+		result = append(result, counts)
+	}
+	if entry.proposal != nil {
+		state := "open"
+		if entry.proposal.Draft {
+			state = "draft"
+		}
+		result = append(result, fmt.Sprintf("proposal #%d %s", entry.proposal.Number, state))
+	}
+	return result
+}
+
+// formatCommitCounts describes how many commits a branch is ahead of and behind the given other branch.
+// Provides an empty string if both branches contain the same commits.
+func formatCommitCounts(ahead, behind int, other string) string {
+	switch {
+	case ahead > 0 && behind > 0:
+		return fmt.Sprintf("%d ahead, %d behind %s", ahead, behind, other)
+	case ahead > 0:
+		return fmt.Sprintf("%d ahead of %s", ahead, other)
+	case behind > 0:
+		return fmt.Sprintf("%d behind %s", behind, other)
+	}
+	return ""
+}
@@ -179,7 +179,7 @@ func validateCompressConfig(config *compressConfig) error {
 		return errors.New(messages.CompressAlreadyOneCommit)
 	}
 	switch config.branchType {
-	case configdomain.BranchTypeParkedBranch, configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeParkedBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		// ok
 	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.CompressIsPerennial)
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const branchTypeDesc = "Displays the type of a branch and the setting that determines it"
+
+const branchTypeHelp = `
+Works on either the current branch or the branch name provided.
+
+Git Town determines the type of a branch in this order:
+the main branch, the perennial branches and the perennial regex,
+the branches listed for the other branch types,
+the first matching branch type rule,
+the contribution and observed regex.
+All other branches are feature branches.`
+
+func branchTypeCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "branch-type [<branch>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: branchTypeDesc,
+		Long:  cmdhelpers.Long(branchTypeDesc, branchTypeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeBranchType(args, readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeBranchType(args []string, verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  true,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	var branch gitdomain.LocalBranchName
+	if len(args) > 0 {
+		branch = gitdomain.NewLocalBranchName(args[0])
+	} else {
+		branch, err = repo.Runner.Backend.CurrentBranch()
+		if err != nil {
+			return err
+		}
+	}
+	config := &repo.Runner.Config.FullConfig
+	match := config.MatchBranchType(branch)
+	fmt.Println()
+	print.Header(fmt.Sprintf(messages.BranchTypeHeader, branch))
+	print.Entry("type", match.BranchType.String())
+	print.Entry("reason", branchTypeReason(match, config.BranchTypeRules))
+	return nil
+}
+
+// branchTypeReason provides a human-readable description of the setting that determines the branch type in the given match.
+func branchTypeReason(match configdomain.BranchTypeMatch, rules configdomain.BranchTypeRules) string {
+	switch match.Source {
+	case configdomain.BranchTypeSourceDefault:
+		return messages.BranchTypeReasonDefault
+	case configdomain.BranchTypeSourceMainBranch:
+		return messages.BranchTypeReasonMainBranch
+	case configdomain.BranchTypeSourceBranchList:
+		return fmt.Sprintf(messages.BranchTypeReasonBranchList, match.BranchType.Name())
+	case configdomain.BranchTypeSourceRule:
+		for r, rule := range rules {
+			if rule == *match.Rule {
+				return fmt.Sprintf(messages.BranchTypeReasonRule, r+1, rule)
+			}
+		}
+	case configdomain.BranchTypeSourceRegex:
+		return fmt.Sprintf(messages.BranchTypeReasonRegex, match.BranchType.Name())
+	}
+	panic("unhandled branch type source")
+}
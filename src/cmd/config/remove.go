@@ -40,7 +40,7 @@ func executeRemoveConfig(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	err = repo.Runner.Config.GitConfig.RemoveLocalGitConfiguration(repo.Runner.Config.FullConfig.Lineage)
+	err = repo.Runner.Config.GitConfig.RemoveLocalGitConfiguration(repo.Runner.Config.FullConfig.Lineage, repo.Runner.Config.FullConfig.SyncStrategyOverrides, repo.Runner.Config.FullConfig.PreviousParentSHAs)
 	if err != nil {
 		return err
 	}
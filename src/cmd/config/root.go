@@ -2,19 +2,25 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/git-town/git-town/v13/src/cli/flags"
 	"github.com/git-town/git-town/v13/src/cli/format"
+	"github.com/git-town/git-town/v13/src/cli/jsonoutput"
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 )
 
 const configDesc = "Displays your Git Town configuration"
 
 func RootCmd() *cobra.Command {
+	addJSONFlag, readJSONFlag := flags.JSON()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	configCmd := cobra.Command{
 		Use:     "config",
@@ -23,16 +29,18 @@ func RootCmd() *cobra.Command {
 		Short:   configDesc,
 		Long:    cmdhelpers.Long(configDesc),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeConfig(readVerboseFlag(cmd))
+			return executeConfig(readJSONFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addJSONFlag(&configCmd)
 	addVerboseFlag(&configCmd)
+	configCmd.AddCommand(branchTypeCommand())
 	configCmd.AddCommand(removeConfigCommand())
 	configCmd.AddCommand(SetupCommand())
 	return &configCmd
 }
 
-func executeConfig(verbose bool) error {
+func executeConfig(json, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  true,
@@ -44,6 +52,13 @@ func executeConfig(verbose bool) error {
 	if err != nil {
 		return err
 	}
+	if json {
+		branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+		if err != nil {
+			return err
+		}
+		return jsonoutput.Print(jsonoutput.NewConfig(repo.Runner.Config, branchesSnapshot.Branches))
+	}
 	printConfig(&repo.Runner.Config.FullConfig)
 	return nil
 }
@@ -53,10 +68,14 @@ func printConfig(config *configdomain.FullConfig) {
 	print.Header("Branches")
 	print.Entry("main branch", format.StringSetting(config.MainBranch.String()))
 	print.Entry("perennial branches", format.StringsSetting((config.PerennialBranches.Join(", "))))
-	print.Entry("perennial regex", format.StringSetting(config.PerennialRegex.String()))
+	print.Entry("perennial regex", format.RegexSetting(config.PerennialRegex.String(), config.PerennialRegex.Problem()))
 	print.Entry("parked branches", format.StringsSetting((config.ParkedBranches.Join(", "))))
 	print.Entry("contribution branches", format.StringsSetting((config.ContributionBranches.Join(", "))))
+	print.Entry("contribution regex", format.RegexSetting(config.ContributionRegex.String(), config.ContributionRegex.Problem()))
 	print.Entry("observed branches", format.StringsSetting((config.ObservedBranches.Join(", "))))
+	print.Entry("observed regex", format.RegexSetting(config.ObservedRegex.String(), config.ObservedRegex.Problem()))
+	print.Entry("prototype branches", format.StringsSetting((config.PrototypeBranches.Join(", "))))
+	print.Entry("branch type rules", format.StringsSetting(config.BranchTypeRules.String()))
 	fmt.Println()
 	print.Header("Configuration")
 	print.Entry("offline", format.Bool(config.Offline.Bool()))
@@ -70,11 +89,39 @@ func printConfig(config *configdomain.FullConfig) {
 	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("hosting platform override", format.StringSetting(config.HostingPlatform.String()))
+	print.Entry("hosting connector command", format.StringSetting(config.HostingConnectorCommand.String()))
+	print.Entry("sync proposal stacks", format.Bool(config.SyncProposalStacks.Bool()))
+	print.Entry("proposal stack template", format.StringSetting(strings.ReplaceAll(config.ProposalStackTemplate.String(), "\n", `\n`)))
 	print.Entry("GitHub token", format.StringSetting(string(config.GitHubToken)))
 	print.Entry("GitLab token", format.StringSetting(string(config.GitLabToken)))
 	print.Entry("Gitea token", format.StringSetting(string(config.GiteaToken)))
+	print.Entry("Azure DevOps token", format.StringSetting(string(config.AzureDevOpsToken)))
+	print.Entry("Bitbucket username", format.StringSetting(string(config.BitbucketUsername)))
+	print.Entry("Bitbucket app password", format.StringSetting(string(config.BitbucketAppPassword)))
 	fmt.Println()
 	if !config.MainBranch.IsEmpty() {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.Lineage))
 	}
+	if branches := syncStrategyBranches(config); len(branches) > 0 {
+		print.Header("Sync Strategies")
+		for _, branch := range branches {
+			print.Entry(branch.String(), config.SyncFeatureStrategyFor(branch).String())
+		}
+		fmt.Println()
+	}
+}
+
+// syncStrategyBranches provides the branches that get synced using a sync-feature strategy, sorted alphabetically.
+func syncStrategyBranches(config *configdomain.FullConfig) gitdomain.LocalBranchNames {
+	candidates := append(config.Lineage.BranchNames(), maps.Keys(config.SyncStrategyOverrides)...)
+	candidates.Sort()
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range slices.Compact(candidates) {
+		switch config.BranchType(branch) {
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+			result = append(result, branch)
+		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		}
+	}
+	return result
 }
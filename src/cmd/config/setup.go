@@ -127,8 +127,20 @@ func enterData(runner *git.ProdRunner, config *setupConfig) (aborted bool, err e
 		return aborted, err
 	}
 	switch determineHostingPlatform(runner, config.userInput.HostingPlatform) {
+	case configdomain.HostingPlatformAzureDevOps:
+		config.userInput.AzureDevOpsToken, aborted, err = dialog.AzureDevOpsToken(runner.Config.FullConfig.AzureDevOpsToken, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
 	case configdomain.HostingPlatformBitbucket:
-		// BitBucket API isn't supported yet
+		config.userInput.BitbucketUsername, aborted, err = dialog.BitbucketUsername(runner.Config.FullConfig.BitbucketUsername, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
+		config.userInput.BitbucketAppPassword, aborted, err = dialog.BitbucketAppPassword(runner.Config.FullConfig.BitbucketAppPassword, config.dialogInputs.Next())
+		if err != nil || aborted {
+			return aborted, err
+		}
 	case configdomain.HostingPlatformGitea:
 		config.userInput.GiteaToken, aborted, err = dialog.GiteaToken(runner.Config.FullConfig.GiteaToken, config.dialogInputs.Next())
 		if err != nil || aborted {
@@ -210,6 +222,18 @@ func saveAll(runner *git.ProdRunner, userInput userInput) error {
 	if err != nil {
 		return err
 	}
+	err = saveAzureDevOpsToken(runner, userInput.AzureDevOpsToken)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketUsername(runner, userInput.BitbucketUsername)
+	if err != nil {
+		return err
+	}
+	err = saveBitbucketAppPassword(runner, userInput.BitbucketAppPassword)
+	if err != nil {
+		return err
+	}
 	err = saveGiteaToken(runner, userInput.GiteaToken)
 	if err != nil {
 		return err
@@ -300,6 +324,27 @@ func saveAliases(runner *git.ProdRunner, newAliases configdomain.Aliases) (err e
 	return nil
 }
 
+func saveAzureDevOpsToken(runner *git.ProdRunner, newToken configdomain.AzureDevOpsToken) error {
+	if newToken == runner.Config.FullConfig.AzureDevOpsToken {
+		return nil
+	}
+	return runner.Frontend.SetAzureDevOpsToken(newToken)
+}
+
+func saveBitbucketAppPassword(runner *git.ProdRunner, newPassword configdomain.BitbucketAppPassword) error {
+	if newPassword == runner.Config.FullConfig.BitbucketAppPassword {
+		return nil
+	}
+	return runner.Frontend.SetBitbucketAppPassword(newPassword)
+}
+
+func saveBitbucketUsername(runner *git.ProdRunner, newUsername configdomain.BitbucketUsername) error {
+	if newUsername == runner.Config.FullConfig.BitbucketUsername {
+		return nil
+	}
+	return runner.Frontend.SetBitbucketUsername(newUsername)
+}
+
 func saveGiteaToken(runner *git.ProdRunner, newToken configdomain.GiteaToken) error {
 	if newToken == runner.Config.FullConfig.GiteaToken {
 		return nil
@@ -360,7 +405,7 @@ func savePerennialBranches(runner *git.ProdRunner, newValue gitdomain.LocalBranc
 }
 
 func savePerennialRegex(runner *git.ProdRunner, newValue configdomain.PerennialRegex) error {
-	if newValue == runner.Config.FullConfig.PerennialRegex {
+	if newValue.String() == runner.Config.FullConfig.PerennialRegex.String() {
 		return nil
 	}
 	return runner.Config.SetPerennialRegexLocally(newValue)
@@ -415,8 +460,16 @@ func saveSyncBeforeShip(runner *git.ProdRunner, newValue configdomain.SyncBefore
 	return runner.Config.SetSyncBeforeShip(newValue, false)
 }
 
+// configFileLineage provides the lineage that the given config file content defines.
+func configFileLineage(configFile *configdomain.PartialConfig) configdomain.Lineage {
+	if configFile == nil || configFile.Lineage == nil {
+		return configdomain.Lineage{}
+	}
+	return *configFile.Lineage
+}
+
 func saveToFile(userInput userInput, runner *git.ProdRunner) error {
-	err := configfile.Save(&userInput.FullConfig)
+	err := configfile.Save(&userInput.FullConfig, configFileLineage(runner.Config.ConfigFile))
 	if err != nil {
 		return err
 	}
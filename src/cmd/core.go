@@ -4,12 +4,15 @@ package cmd
 import (
 	"github.com/git-town/git-town/v13/src/cmd/config"
 	"github.com/git-town/git-town/v13/src/cmd/debug"
+	"github.com/git-town/git-town/v13/src/cmd/lineage"
+	"github.com/git-town/git-town/v13/src/cmd/proposals"
 )
 
 // Execute runs the Cobra stack.
 func Execute() error {
 	rootCmd := rootCmd()
 	rootCmd.AddCommand(appendCmd())
+	rootCmd.AddCommand(branchCommand())
 	rootCmd.AddCommand(completionsCmd(&rootCmd))
 	rootCmd.AddCommand(compressCmd())
 	rootCmd.AddCommand(config.RootCmd())
@@ -18,21 +21,28 @@ func Execute() error {
 	rootCmd.AddCommand(debug.RootCmd())
 	rootCmd.AddCommand(diffParentCommand())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(historyCommand())
 	rootCmd.AddCommand(killCommand())
+	rootCmd.AddCommand(lineage.RootCmd())
+	rootCmd.AddCommand(moveCmd())
 	rootCmd.AddCommand(newPullRequestCommand())
 	rootCmd.AddCommand(observeCmd())
 	rootCmd.AddCommand(offlineCmd())
 	rootCmd.AddCommand(parkCmd())
+	rootCmd.AddCommand(proposals.RootCmd())
 	rootCmd.AddCommand(proposeCommand())
 	rootCmd.AddCommand(prependCommand())
+	rootCmd.AddCommand(prototypeCmd())
 	rootCmd.AddCommand(renameBranchCommand())
 	rootCmd.AddCommand(repoCommand())
 	rootCmd.AddCommand(statusCommand())
 	rootCmd.AddCommand(setParentCommand())
 	rootCmd.AddCommand(shipCmd())
 	rootCmd.AddCommand(skipCmd())
+	rootCmd.AddCommand(splitCmd())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(syncCmd())
 	rootCmd.AddCommand(undoCmd())
+	rootCmd.AddCommand(worktreeCmd())
 	return rootCmd.Execute()
 }
@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog"
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+func enterAzureDevOpsToken() *cobra.Command {
+	return &cobra.Command{
+		Use: "azure-devops-token",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.AzureDevOpsToken(configdomain.AzureDevOpsToken(""), dialogInputs.Next())
+			return err
+		},
+	}
+}
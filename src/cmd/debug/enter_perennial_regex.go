@@ -14,7 +14,7 @@ func enterPerennialRegex() *cobra.Command {
 		Use: "perennial-regex",
 		RunE: func(_ *cobra.Command, _ []string) error {
 			dialogInputs := components.LoadTestInputs(os.Environ())
-			_, _, err := dialog.PerennialRegex(configdomain.PerennialRegex(""), dialogInputs.Next())
+			_, _, err := dialog.PerennialRegex(configdomain.NewPerennialRegex(""), dialogInputs.Next())
 			return err
 		},
 	}
@@ -11,6 +11,9 @@ func RootCmd() *cobra.Command {
 		Hidden: true,
 	}
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterAzureDevOpsToken())
+	debugCommand.AddCommand(enterBitbucketAppPassword())
+	debugCommand.AddCommand(enterBitbucketUsername())
 	debugCommand.AddCommand(enterHostingPlatform())
 	debugCommand.AddCommand(enterGiteaToken())
 	debugCommand.AddCommand(enterGitHubToken())
@@ -28,6 +31,7 @@ func RootCmd() *cobra.Command {
 	debugCommand.AddCommand(enterShipDeleteTrackingBranch())
 	debugCommand.AddCommand(enterSyncBeforeShip())
 	debugCommand.AddCommand(selectCommitAuthorCmd())
+	debugCommand.AddCommand(selectSplitCommitCmd())
 	debugCommand.AddCommand(switchBranch())
 	debugCommand.AddCommand(unfinishedStateCommitAuthorCmd())
 	debugCommand.AddCommand(welcome())
@@ -0,0 +1,28 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog"
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/spf13/cobra"
+)
+
+func selectSplitCommitCmd() *cobra.Command {
+	return &cobra.Command{
+		Use: "select-split-commit",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			branch := gitdomain.NewLocalBranchName("feature-branch")
+			newBranch := gitdomain.NewLocalBranchName("new-branch")
+			commits := gitdomain.Commits{
+				{Message: "commit 1", SHA: gitdomain.NewSHA("1111111111")},
+				{Message: "commit 2", SHA: gitdomain.NewSHA("2222222222")},
+				{Message: "commit 3", SHA: gitdomain.NewSHA("3333333333")},
+			}
+			dialogTestInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.SplitCommit(branch, newBranch, commits, dialogTestInputs.Next())
+			return err
+		},
+	}
+}
@@ -19,12 +19,32 @@ func switchBranch() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			localBranches := gitdomain.LocalBranchNames{}
+			branches := gitdomain.BranchInfos{}
 			for i := 0; i < int(amount); i++ {
-				localBranches = append(localBranches, gitdomain.NewLocalBranchName(fmt.Sprintf("branch-%d", i)))
+				branches = append(branches, gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName(fmt.Sprintf("branch-%d", i)),
+					LocalSHA:   gitdomain.EmptySHA(),
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+				})
 			}
-			lineage := configdomain.Lineage{}
-			_, _, err = dialog.SwitchBranch(localBranches, gitdomain.NewLocalBranchName("branch-2"), lineage)
+			config := configdomain.DefaultConfig()
+			config.MainBranch = gitdomain.NewLocalBranchName("branch-0")
+			config.Lineage = configdomain.Lineage{}
+			for b := 1; b < len(branches); b++ {
+				config.Lineage[branches[b].LocalName] = config.MainBranch
+			}
+			_, _, err = dialog.SwitchBranch(dialog.SwitchBranchArgs{
+				Branches:      branches,
+				Config:        &config,
+				InitialBranch: gitdomain.NewLocalBranchName("branch-2"),
+				LoadCommits: func(branch, _ gitdomain.LocalBranchName) (gitdomain.Commits, error) {
+					return gitdomain.Commits{
+						{Message: gitdomain.CommitMessage("commit in " + branch.String()), SHA: gitdomain.NewSHA("111111111111")},
+					}, nil
+				},
+			})
 			return err
 		},
 	}
@@ -215,6 +215,8 @@ func makeFeatureBranch(args makeFeatureBranchArgs) error {
 			err = args.config.RemoveFromObservedBranches(branchName)
 		case configdomain.BranchTypeParkedBranch:
 			err = args.config.RemoveFromParkedBranches(branchName)
+		case configdomain.BranchTypePrototypeBranch:
+			err = args.config.RemoveFromPrototypeBranches(branchName)
 		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
 			panic(fmt.Sprintf("unchecked branch type: %s", branchType))
 		}
@@ -245,7 +247,7 @@ type makeFeatureBranchArgs struct {
 func validateMakeFeatureConfig(config *makeFeatureConfig) error {
 	for branchName, branchType := range config.targetBranches {
 		switch branchType {
-		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+		case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 			return nil
 		case configdomain.BranchTypeFeatureBranch:
 			return fmt.Errorf(messages.HackBranchIsAlreadyFeature, branchName)
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/format"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/git-town/git-town/v13/src/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const historyDesc = "Displays the Git Town commands that you can undo"
+
+const historyHelp = `
+Lists the most recently executed Git Town commands, newest first.
+Use "git town undo --steps <number>" to undo several of them at once.`
+
+func historyCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "history",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   historyDesc,
+		Long:    cmdhelpers.Long(historyDesc, historyHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeHistory(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeHistory(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	history, err := statefile.LoadHistory(repo.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateLoadProblem, err)
+	}
+	displayHistory(history)
+	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
+	return nil
+}
+
+func displayHistory(history runstate.History) {
+	if len(history) == 0 {
+		fmt.Println(messages.UndoNothingToDo)
+		return
+	}
+	undoableCount := history.UndoableCount()
+	for e, entry := range history {
+		print.Header(fmt.Sprintf("%d. %s (%s)", e+1, entry.RunState.Command, humanize.Time(entry.EndTime)))
+		print.Entry("status", historyEntryStatus(entry.RunState))
+		print.Entry("branches before", branchesSnapshotSummary(entry.RunState.BeginBranchesSnapshot))
+		print.Entry("branches after", branchesSnapshotSummary(entry.RunState.EndBranchesSnapshot))
+		print.Entry("can be undone", format.Bool(e < undoableCount))
+		fmt.Println()
+	}
+}
+
+func branchesSnapshotSummary(snapshot gitdomain.BranchesSnapshot) string {
+	if snapshot.IsEmpty() {
+		return "unchanged"
+	}
+	return fmt.Sprintf("%d local branches, %q checked out", len(snapshot.Branches.LocalBranches()), snapshot.Active)
+}
+
+func historyEntryStatus(runState runstate.RunState) string {
+	switch {
+	case !runState.IsFinished():
+		return "unfinished"
+	case runState.DryRun:
+		return "dry run"
+	default:
+		return "finished"
+	}
+}
@@ -30,21 +30,23 @@ Deletes the current or provided branch from the local and origin repositories. D
 func killCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
 	cmd := cobra.Command{
 		Use:   "kill [<branch>]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: killDesc,
 		Long:  cmdhelpers.Long(killDesc, killHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeKill(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeKill(args, readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func executeKill(args []string, dryRun, verbose bool) error {
+func executeKill(args []string, dryRun, verbose, yes bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -56,7 +58,7 @@ func executeKill(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineKillConfig(args, repo, dryRun, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
@@ -104,7 +106,7 @@ type killConfig struct {
 	previousBranch   gitdomain.LocalBranchName
 }
 
-func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*killConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -133,7 +135,9 @@ func determineKillConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 			AllBranches:      branchesSnapshot.Branches,
 			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 			DialogTestInputs: &dialogTestInputs,
+			InferParents:     true,
 			Runner:           repo.Runner,
+			Yes:              yes,
 		})
 		if err != nil {
 			return nil, branchesSnapshot, stashSize, false, err
@@ -167,7 +171,7 @@ func (self killConfig) branchToKillParent() gitdomain.LocalBranchName {
 func killProgram(config *killConfig) (runProgram, finalUndoProgram program.Program) {
 	prog := program.Program{}
 	switch config.branchTypeToKill {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		killFeatureBranch(&prog, &finalUndoProgram, config)
 	case configdomain.BranchTypeObservedBranch, configdomain.BranchTypeContributionBranch:
 		killLocalBranch(&prog, &finalUndoProgram, config)
@@ -217,7 +221,7 @@ func killLocalBranch(prog *program.Program, finalUndoProgram *program.Program, c
 
 func validateKillConfig(killConfig *killConfig) error {
 	switch killConfig.branchTypeToKill {
-	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeObservedBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.KillCannotKillMainBranch)
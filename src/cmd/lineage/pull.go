@@ -0,0 +1,77 @@
+package lineage
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const pullDesc = "Adds the parent branches from origin to your configuration"
+
+const pullHelp = `
+Downloads the shared lineage from the "origin" remote and stores the parent branches it contains for your local branches whose parent Git Town doesn't know yet. Parent branches in your local configuration remain unchanged.`
+
+func pullCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "pull",
+		Args:  cobra.NoArgs,
+		Short: pullDesc,
+		Long:  cmdhelpers.Long(pullDesc, pullHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeLineagePull(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeLineagePull(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	err = enableSharedLineage(repo.Runner)
+	if err != nil {
+		return err
+	}
+	err = repo.Runner.Frontend.Fetch()
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return err
+	}
+	added, err := execute.MergeSharedLineage(repo.Runner, branchesSnapshot.Branches)
+	if err != nil {
+		return err
+	}
+	if len(added) == 0 {
+		fmt.Print(messages.LineagePulledNothing)
+	}
+	for _, branch := range added.BranchNames() {
+		fmt.Printf(messages.LineagePulledParent, branch, added[branch])
+	}
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "lineage pull",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
@@ -0,0 +1,112 @@
+package lineage
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	configInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/config"
+	"github.com/spf13/cobra"
+)
+
+const pushDesc = "Uploads the parent branches of your branches to origin"
+
+// how often "git town lineage push" tries to upload the shared lineage
+// when somebody else pushes a new version of it in the meantime
+const pushAttempts = 3
+
+const pushHelp = `
+Adds the parent branches of all branches that exist at the "origin" remote to the shared lineage and uploads it to origin. Parent branches in your local configuration override those in the shared lineage. Entries for branches that no longer exist at origin get removed from the shared lineage.`
+
+func pushCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "push",
+		Args:  cobra.NoArgs,
+		Short: pushDesc,
+		Long:  cmdhelpers.Long(pushDesc, pushHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeLineagePush(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeLineagePush(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	err = enableSharedLineage(repo.Runner)
+	if err != nil {
+		return err
+	}
+	var lineage configdomain.Lineage
+	for attempt := 1; ; attempt++ {
+		err = repo.Runner.Frontend.Fetch()
+		if err != nil {
+			return err
+		}
+		lineage, err = pushSharedLineage(repo.Runner)
+		if err == nil {
+			break
+		}
+		if attempt == pushAttempts {
+			return err
+		}
+		fmt.Print(messages.LineagePushRetry)
+	}
+	fmt.Printf(messages.LineagePushed, len(lineage))
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		BeginConfigSnapshot: repo.ConfigSnapshot,
+		Command:             "lineage push",
+		EndConfigSnapshot:   undoconfig.EmptyConfigSnapshot(),
+		RootDir:             repo.RootDir,
+		Runner:              repo.Runner,
+		Verbose:             verbose,
+	})
+}
+
+// pushSharedLineage adds the local lineage to the shared lineage that was fetched last
+// and uploads the result to origin, as long as nobody has pushed the shared lineage since this fetch.
+// It provides the uploaded shared lineage.
+func pushSharedLineage(runner *git.ProdRunner) (configdomain.Lineage, error) {
+	branchesSnapshot, err := runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return configdomain.Lineage{}, err
+	}
+	fetchedSHA := runner.Backend.SharedLineageSHA()
+	sharedLineage, _ := runner.Backend.SharedLineage()
+	lineage := sharedLineageToPush(runner.Config.FullConfig.Lineage, sharedLineage, branchesSnapshot.Branches)
+	err = runner.Backend.WriteSharedLineage(lineage)
+	if err != nil {
+		return lineage, err
+	}
+	return lineage, runner.Frontend.PushSharedLineage(fetchedSHA)
+}
+
+// sharedLineageToPush provides the shared lineage that contains the given local lineage
+// for the branches that exist at origin.
+func sharedLineageToPush(localLineage, sharedLineage configdomain.Lineage, branches gitdomain.BranchInfos) configdomain.Lineage {
+	result := configdomain.Lineage{}
+	for branch, parent := range localLineage.Merge(sharedLineage) {
+		if branches.FindByRemoteName(branch.AtRemote(gitdomain.RemoteOrigin)) != nil {
+			result[branch] = parent
+		}
+	}
+	return result
+}
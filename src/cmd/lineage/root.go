@@ -0,0 +1,53 @@
+// Package lineage defines the Git Town commands that share the lineage with the other clones of the repository.
+package lineage
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/spf13/cobra"
+)
+
+const lineageDesc = "Shares the parent branches with the other clones of the repository"
+
+const lineageHelp = `
+Git Town stores the parent branches of your feature branches in the local Git configuration. The subcommands of this command exchange this information with the other clones of the repository via a Git ref at the "origin" remote, so that your teammates don't have to enter the parents of the branches you share with them.
+
+Running one of these subcommands enables the shared lineage for this repository. From then on, "git fetch" downloads the shared lineage and Git Town adds the parents of your local branches that it contains to the local configuration. Parent branches in the local configuration take precedence over the shared lineage.`
+
+func RootCmd() *cobra.Command {
+	lineageCmd := cobra.Command{
+		Use:     "lineage",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   lineageDesc,
+		Long:    cmdhelpers.Long(lineageDesc, lineageHelp),
+	}
+	lineageCmd.AddCommand(pullCommand())
+	lineageCmd.AddCommand(pushCommand())
+	return &lineageCmd
+}
+
+// enableSharedLineage makes this clone of the repository fetch the shared lineage
+// and merge it into the local configuration.
+func enableSharedLineage(runner *git.ProdRunner) error {
+	remotes, err := runner.Backend.Remotes()
+	if err != nil {
+		return err
+	}
+	if !remotes.HasOrigin() {
+		return errors.New(messages.LineageNoOrigin)
+	}
+	if !runner.Config.FullConfig.ShareLineage {
+		err = runner.Config.SetShareLineage(true, false)
+		if err != nil {
+			return err
+		}
+	}
+	if !runner.Backend.FetchesSharedLineage() {
+		return runner.Frontend.AddSharedLineageRefspec()
+	}
+	return nil
+}
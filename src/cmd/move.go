@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const moveDesc = "Swaps the current branch with its parent or child branch"
+
+const moveHelp = `
+With --up, swaps the current feature branch with its parent branch: the current branch becomes the parent of its former parent. The parent branch must not have other child branches. With --down, swaps the current feature branch with its only child branch.
+
+Rebases both swapped branches so that each contains only its own commits on top of its new parent, force-pushes them if they have tracking branches, and updates the targets of their proposals. The children of the branch that moves up become children of the branch that moves down.
+Branches must be synced before you move them.`
+
+func moveCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
+	addUpFlag, readUpFlag := flags.Bool("up", "u", "Swap the current branch with its parent", flags.FlagTypeNonPersistent)
+	addDownFlag, readDownFlag := flags.Bool("down", "d", "Swap the current branch with its child", flags.FlagTypeNonPersistent)
+	cmd := cobra.Command{
+		Use:     "move",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   moveDesc,
+		Long:    cmdhelpers.Long(moveDesc, moveHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeMove(readUpFlag(cmd), readDownFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
+		},
+	}
+	addUpFlag(&cmd)
+	addDownFlag(&cmd)
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
+	return &cmd
+}
+
+func executeMove(up, down, dryRun, verbose, yes bool) error {
+	if up == down {
+		return errors.New(messages.MoveDirectionMissing)
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineMoveConfig(up, repo, dryRun, verbose, yes)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "move",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            moveProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               config.connector,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type moveConfig struct {
+	*configdomain.FullConfig
+	connector        hostingdomain.Connector
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	grandParent      gitdomain.LocalBranchName // the current parent of the lower branch, becomes the parent of the upper branch
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	lower            gitdomain.BranchInfo // the branch that moves down in the stack
+	previousBranch   gitdomain.LocalBranchName
+	proposals        []moveProposal // proposals whose target branch changes
+	remotes          gitdomain.Remotes
+	upper            gitdomain.BranchInfo  // the branch that moves up in the stack
+	upperChildren    gitdomain.BranchInfos // the children of the upper branch, they become children of the lower branch
+}
+
+// moveProposal describes a proposal that needs to target another branch after moving.
+type moveProposal struct {
+	newTarget gitdomain.LocalBranchName
+	number    int
+	oldTarget gitdomain.LocalBranchName
+}
+
+// moveRetarget describes a branch whose proposal might need to target another branch after moving.
+type moveRetarget struct {
+	branch    gitdomain.LocalBranchName
+	newTarget gitdomain.LocalBranchName
+	oldTarget gitdomain.LocalBranchName
+}
+
+func determineMoveConfig(up bool, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*moveConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	remotes := fc.Remotes(repo.Runner.Backend.Remotes())
+	initialBranch := branchesSnapshot.Active
+	if repo.Runner.Config.FullConfig.BranchType(initialBranch) != configdomain.BranchTypeFeatureBranch {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.SetParentNoFeatureBranch, initialBranch)
+	}
+	err = execute.EnsureKnownBranchAncestry(initialBranch, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
+		Runner:           repo.Runner,
+		Yes:              yes,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	lineage := repo.Runner.Config.FullConfig.Lineage
+	var upperName, lowerName gitdomain.LocalBranchName
+	if up {
+		upperName = initialBranch
+		lowerName = lineage.Parent(initialBranch)
+		if repo.Runner.Config.FullConfig.BranchType(lowerName) != configdomain.BranchTypeFeatureBranch {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MoveUpParentNotFeature, initialBranch, lowerName)
+		}
+		if len(lineage.Children(lowerName)) > 1 {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MoveUpParentMultipleChildren, initialBranch, lowerName)
+		}
+	} else {
+		children := lineage.Children(initialBranch)
+		switch len(children) {
+		case 0:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MoveDownNoChild, initialBranch)
+		case 1:
+		default:
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MoveDownMultipleChildren, initialBranch)
+		}
+		upperName = children[0]
+		lowerName = initialBranch
+	}
+	upper := branchesSnapshot.Branches.FindByLocalName(upperName)
+	if upper == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, upperName)
+	}
+	lower := branchesSnapshot.Branches.FindByLocalName(lowerName)
+	if lower == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, lowerName)
+	}
+	for _, branch := range []gitdomain.BranchInfo{*lower, *upper} {
+		if branch.SyncStatus != gitdomain.SyncStatusUpToDate && branch.SyncStatus != gitdomain.SyncStatusLocalOnly {
+			return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.MoveUnsynced, branch.LocalName)
+		}
+	}
+	grandParent := lineage.Parent(lowerName)
+	upperChildNames := lineage.Children(upperName)
+	upperChildren := fc.BranchInfos(branchesSnapshot.Branches.Select(upperChildNames))
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &repo.Runner.Config.FullConfig,
+		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	proposals := []moveProposal{}
+	if !repo.IsOffline && connector != nil {
+		retargets := []moveRetarget{
+			{branch: upperName, newTarget: grandParent, oldTarget: lowerName},
+			{branch: lowerName, newTarget: upperName, oldTarget: grandParent},
+		}
+		for _, child := range upperChildNames {
+			retargets = append(retargets, moveRetarget{branch: child, newTarget: lowerName, oldTarget: upperName})
+		}
+		for _, retarget := range retargets {
+			proposal, err := connector.FindProposal(retarget.branch, retarget.oldTarget)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, retarget.branch, err)
+			}
+			if proposal != nil {
+				proposals = append(proposals, moveProposal{newTarget: retarget.newTarget, number: proposal.Number, oldTarget: retarget.oldTarget})
+			}
+		}
+	}
+	return &moveConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		grandParent:      grandParent,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		lower:            *lower,
+		previousBranch:   previousBranch,
+		proposals:        proposals,
+		remotes:          remotes,
+		upper:            *upper,
+		upperChildren:    upperChildren,
+	}, branchesSnapshot, stashSize, false, fc.Err
+}
+
+func moveProgram(config *moveConfig) program.Program {
+	prog := program.Program{}
+	shouldPush := config.remotes.HasOrigin() && config.IsOnline()
+	// the upper branch keeps only its own commits, on top of the grandparent
+	prog.Add(&opcodes.Checkout{Branch: config.upper.LocalName})
+	prog.Add(&opcodes.RebaseOnto{
+		BranchToRebaseOnto: config.grandParent.BranchName(),
+		CommitsToRemove:    config.lower.LocalName.Location(),
+	})
+	if shouldPush && config.upper.HasTrackingBranch() {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+	// the lower branch now builds on top of the upper branch
+	prog.Add(&opcodes.Checkout{Branch: config.lower.LocalName})
+	prog.Add(&opcodes.RebaseBranch{Branch: config.upper.LocalName.BranchName()})
+	if shouldPush && config.lower.HasTrackingBranch() {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+	// the children of the upper branch keep only their own commits, on top of the lower branch
+	for _, child := range config.upperChildren {
+		prog.Add(&opcodes.Checkout{Branch: child.LocalName})
+		prog.Add(&opcodes.RebaseOnto{
+			BranchToRebaseOnto: config.lower.LocalName.BranchName(),
+			CommitsToRemove:    config.upper.LocalSHA.Location(),
+		})
+		if shouldPush && child.HasTrackingBranch() {
+			prog.Add(&opcodes.ForcePushCurrentBranch{})
+		}
+	}
+	prog.Add(&opcodes.ChangeParent{
+		Branch: config.upper.LocalName,
+		Parent: config.grandParent,
+	})
+	prog.Add(&opcodes.ChangeParent{
+		Branch: config.lower.LocalName,
+		Parent: config.upper.LocalName,
+	})
+	for _, child := range config.upperChildren {
+		prog.Add(&opcodes.ChangeParent{
+			Branch: child.LocalName,
+			Parent: config.lower.LocalName,
+		})
+	}
+	for _, proposal := range config.proposals {
+		prog.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      proposal.newTarget,
+			OldTarget:      proposal.oldTarget,
+			ProposalNumber: proposal.number,
+		})
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         config.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
@@ -23,7 +23,7 @@ func newPullRequestCommand() *cobra.Command {
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			printDeprecationNotice()
-			result := executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			result := executePropose(proposeArgs{body: "", draft: false, noBrowser: false, title: ""}, readDryRunFlag(cmd), readVerboseFlag(cmd), false)
 			printDeprecationNotice()
 			return result
 		},
@@ -31,6 +31,7 @@ See "sync" for upstream remote options.`
 func prependCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
 	cmd := cobra.Command{
 		Use:     "prepend <branch>",
 		GroupID: "lineage",
@@ -38,15 +39,16 @@ func prependCommand() *cobra.Command {
 		Short:   prependDesc,
 		Long:    cmdhelpers.Long(prependDesc, prependHelp),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executePrepend(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executePrepend(args, readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func executePrepend(args []string, dryRun, verbose bool) error {
+func executePrepend(args []string, dryRun, verbose, yes bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -58,7 +60,7 @@ func executePrepend(args []string, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determinePrependConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determinePrependConfig(args, repo, dryRun, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
@@ -103,7 +105,7 @@ type prependConfig struct {
 	targetBranch              gitdomain.LocalBranchName
 }
 
-func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*prependConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*prependConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	fc := execute.FailureCollector{}
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
@@ -136,7 +138,9 @@ func determinePrependConfig(args []string, repo *execute.OpenRepoResult, dryRun,
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
 		Runner:           repo.Runner,
+		Yes:              yes,
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -172,6 +176,7 @@ func prependProgram(config *prependConfig) program.Program {
 			Program:       &prog,
 			PushBranch:    true,
 			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
 		})
 	}
 	prog.Add(&opcodes.CreateBranchExistingParent{
@@ -189,7 +194,7 @@ func prependProgram(config *prependConfig) program.Program {
 		Parent: config.targetBranch,
 	})
 	prog.Add(&opcodes.Checkout{Branch: config.targetBranch})
-	if config.remotes.HasOrigin() && config.ShouldPushNewBranches() && config.IsOnline() {
+	if config.remotes.HasOrigin() && config.ShouldPushNewBranch(config.targetBranch) && config.IsOnline() {
 		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
 	}
 	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
@@ -0,0 +1,21 @@
+// Package proposals defines the Git Town commands that manage proposals at the code hosting platform.
+package proposals
+
+import (
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/spf13/cobra"
+)
+
+const proposalsDesc = "Manages the proposals of your branches"
+
+func RootCmd() *cobra.Command {
+	proposalsCmd := cobra.Command{
+		Use:     "proposals",
+		GroupID: "lineage",
+		Args:    cobra.NoArgs,
+		Short:   proposalsDesc,
+		Long:    cmdhelpers.Long(proposalsDesc),
+	}
+	proposalsCmd.AddCommand(updateStackCommand())
+	return &proposalsCmd
+}
@@ -0,0 +1,93 @@
+package proposals
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/gitconfig"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/validate"
+	"github.com/spf13/cobra"
+)
+
+const updateStackDesc = "Adds stack navigation to the proposals of the current stack"
+
+const updateStackHelp = `
+Adds a section to the description of each proposal in the stack that the current branch is in. This section lists the ancestor and descendant branches of the proposal together with the links to their proposals, so that reviewers see where the proposal sits in the stack.
+
+"git town sync" keeps these sections up to date. You can customize the section with "git config %s <TEMPLATE>".`
+
+func updateStackCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "update-stack",
+		Args:  cobra.NoArgs,
+		Short: updateStackDesc,
+		Long:  cmdhelpers.Long(updateStackDesc, fmt.Sprintf(updateStackHelp, gitconfig.KeyProposalStackTemplate)),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeUpdateStack(readVerboseFlag(cmd))
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeUpdateStack(verbose bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           false,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: true,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, err := repo.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return err
+	}
+	dialogInputs := components.LoadTestInputs(os.Environ())
+	err = validate.IsConfigured(&repo.Runner.Backend, &repo.Runner.Config.FullConfig, branchesSnapshot.Branches.LocalBranches().Names(), &dialogInputs)
+	if err != nil {
+		return err
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &repo.Runner.Config.FullConfig,
+		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil {
+		return err
+	}
+	if connector == nil {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	if !proposalstack.IsStacked(branchesSnapshot.Active, repo.Runner.Config.FullConfig.Lineage) {
+		return fmt.Errorf(messages.ProposalStackNoStack, branchesSnapshot.Active)
+	}
+	err = proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+		AddSections:       true,
+		Branches:          gitdomain.LocalBranchNames{branchesSnapshot.Active},
+		Connector:         connector,
+		Lineage:           repo.Runner.Config.FullConfig.Lineage,
+		OnlyGivenBranches: false,
+		Template:          repo.Runner.Config.FullConfig.ProposalStackTemplate,
+	})
+	if err != nil {
+		return err
+	}
+	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), repo.Runner.FinalMessages.Result())
+	return nil
+}
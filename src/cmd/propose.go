@@ -13,6 +13,7 @@ import (
 	"github.com/git-town/git-town/v13/src/config/gitconfig"
 	"github.com/git-town/git-town/v13/src/execute"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/gohacks/slice"
 	"github.com/git-town/git-town/v13/src/hosting"
 	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v13/src/messages"
@@ -32,11 +33,18 @@ Syncs the current branch and opens a browser window to the new proposal page of
 
 The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
 
-Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
+When called with --title, --body, --draft, or --no-browser, this command creates the proposal via the API of your hosting platform and prints its URL. This allows creating proposals from scripts or over SSH. Without --title, the proposal is titled after the branch name.
+
+Supported only for repositories hosted on GitHub, GitLab, Gitea, Bitbucket and Azure DevOps. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", "bitbucket", or "azure-devops". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
+	addTitleFlag, readTitleFlag := flags.String("title", "t", "Title of the proposal to create via the API", flags.FlagTypeNonPersistent)
+	addBodyFlag, readBodyFlag := flags.String("body", "b", "Description of the proposal to create via the API", flags.FlagTypeNonPersistent)
+	addDraftFlag, readDraftFlag := flags.Bool("draft", "", "Create the proposal via the API as a draft", flags.FlagTypeNonPersistent)
+	addNoBrowserFlag, readNoBrowserFlag := flags.Bool("no-browser", "", "Create the proposal via the API without opening the browser", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "propose",
 		GroupID: "basic",
@@ -44,15 +52,38 @@ func proposeCommand() *cobra.Command {
 		Short:   proposeDesc,
 		Long:    cmdhelpers.Long(proposeDesc, fmt.Sprintf(proposeHelp, gitconfig.KeyHostingPlatform, gitconfig.KeyHostingOriginHostname)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executePropose(readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executePropose(proposeArgs{
+				body:      readBodyFlag(cmd),
+				draft:     readDraftFlag(cmd),
+				noBrowser: readNoBrowserFlag(cmd),
+				title:     readTitleFlag(cmd),
+			}, readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
+	addBodyFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addNoBrowserFlag(&cmd)
+	addTitleFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(dryRun, verbose bool) error {
+// proposeArgs contains the CLI arguments for creating the proposal via the API.
+type proposeArgs struct {
+	body      string
+	draft     bool
+	noBrowser bool
+	title     string
+}
+
+// viaAPI indicates whether the user wants to create the proposal via the API of the hosting platform.
+func (self proposeArgs) viaAPI() bool {
+	return self.title != "" || self.body != "" || self.draft || self.noBrowser
+}
+
+func executePropose(args proposeArgs, dryRun, verbose, yes bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -64,7 +95,7 @@ func executePropose(dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineProposeConfig(args, repo, dryRun, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
@@ -100,6 +131,7 @@ func executePropose(dryRun, verbose bool) error {
 type proposeConfig struct {
 	*configdomain.FullConfig
 	allBranches      gitdomain.BranchInfos
+	args             proposeArgs
 	branchesToSync   gitdomain.BranchInfos
 	connector        hostingdomain.Connector
 	dialogTestInputs components.TestInputs
@@ -107,10 +139,11 @@ type proposeConfig struct {
 	hasOpenChanges   bool
 	initialBranch    gitdomain.LocalBranchName
 	previousBranch   gitdomain.LocalBranchName
+	prototypes       gitdomain.LocalBranchNames // the prototype branches that become feature branches
 	remotes          gitdomain.Remotes
 }
 
-func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineProposeConfig(args proposeArgs, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*proposeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -135,7 +168,9 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
 		Runner:           repo.Runner,
+		Yes:              yes,
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -155,9 +190,21 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 	}
 	branchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchAndAncestors(branchesSnapshot.Active)
 	branchesToSync, err := branchesSnapshot.Branches.Select(branchNamesToSync)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	// proposing prototype branches requires them at origin, hence they become feature branches
+	prototypes := gitdomain.LocalBranchNames{}
+	for _, branch := range branchesToSync {
+		if slice.Contains(repo.Runner.Config.FullConfig.PrototypeBranches, branch.LocalName) {
+			prototypes = append(prototypes, branch.LocalName)
+			repo.Runner.Config.FullConfig.PrototypeBranches = slice.Remove(repo.Runner.Config.FullConfig.PrototypeBranches, branch.LocalName)
+		}
+	}
 	return &proposeConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
 		allBranches:      branchesSnapshot.Branches,
+		args:             args,
 		branchesToSync:   branchesToSync,
 		connector:        connector,
 		dialogTestInputs: dialogTestInputs,
@@ -165,18 +212,23 @@ func determineProposeConfig(repo *execute.OpenRepoResult, dryRun, verbose bool)
 		hasOpenChanges:   repoStatus.OpenChanges,
 		initialBranch:    branchesSnapshot.Active,
 		previousBranch:   previousBranch,
+		prototypes:       prototypes,
 		remotes:          remotes,
-	}, branchesSnapshot, stashSize, false, err
+	}, branchesSnapshot, stashSize, false, nil
 }
 
 func proposeProgram(config *proposeConfig) program.Program {
 	prog := program.Program{}
+	for _, prototype := range config.prototypes {
+		prog.Add(&opcodes.RemoveFromPrototypeBranches{Branch: prototype})
+	}
 	for _, branch := range config.branchesToSync {
 		sync.BranchProgram(branch, sync.BranchProgramArgs{
 			Config:        config.FullConfig,
 			BranchInfos:   config.allBranches,
 			InitialBranch: config.initialBranch,
 			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
 			Program:       &prog,
 			PushBranch:    true,
 		})
@@ -187,15 +239,27 @@ func proposeProgram(config *proposeConfig) program.Program {
 		StashOpenChanges:         config.hasOpenChanges,
 		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
 	})
-	prog.Add(&opcodes.CreateProposal{Branch: config.initialBranch})
+	title := ""
+	if config.args.viaAPI() {
+		title = config.args.title
+		if title == "" {
+			title = config.initialBranch.String()
+		}
+	}
+	prog.Add(&opcodes.CreateProposal{
+		Body:      config.args.body,
+		Branch:    config.initialBranch,
+		Draft:     config.args.draft,
+		NoBrowser: config.args.noBrowser,
+		Title:     title,
+	})
 	return prog
 }
 
 func validateProposeConfig(config *proposeConfig) error {
 	initialBranchType := config.FullConfig.BranchType(config.initialBranch)
 	switch initialBranchType {
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
-		return nil
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotPropose)
 	case configdomain.BranchTypeContributionBranch:
@@ -204,6 +268,14 @@ func validateProposeConfig(config *proposeConfig) error {
 		return errors.New(messages.ObservedBranchCannotPropose)
 	case configdomain.BranchTypePerennialBranch:
 		return errors.New(messages.PerennialBranchCannotPropose)
+	default:
+		panic(fmt.Sprintf("unhandled branch type: %v", initialBranchType))
+	}
+	for _, branch := range config.branchesToSync {
+		match := config.MatchBranchType(branch.LocalName)
+		if match.BranchType == configdomain.BranchTypePrototypeBranch && match.Source == configdomain.BranchTypeSourceRule {
+			return fmt.Errorf(messages.PrototypeBranchCannotPropose, branch.LocalName, match.Rule.String())
+		}
 	}
-	panic(fmt.Sprintf("unhandled branch type: %v", initialBranchType))
+	return nil
 }
@@ -20,7 +20,7 @@ import (
 const repoDesc = "Opens the repository homepage"
 
 const repoHelp = `
-Supported for repositories hosted on GitHub, GitLab, Gitea, and Bitbucket. Derives the Git provider from the "origin" remote. You can override this detection with "git config %s <DRIVER>" where DRIVER is "github", "gitlab", "gitea", or "bitbucket".
+Supported for repositories hosted on GitHub, GitLab, Gitea, Bitbucket, and Azure DevOps. Derives the Git provider from the "origin" remote. You can override this detection with "git config %s <DRIVER>" where DRIVER is "github", "gitlab", "gitea", "bitbucket", or "azure-devops".
 
 When using SSH identities, run "git config %s <HOSTNAME>" where HOSTNAME matches what is in your ssh config file.`
 
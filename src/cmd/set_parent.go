@@ -8,30 +8,42 @@ import (
 	"github.com/git-town/git-town/v13/src/cli/flags"
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
 	"github.com/spf13/cobra"
 )
 
 const setParentDesc = "Prompts to set the parent branch for the current branch"
 
+const setParentHelp = `
+With --rebase, also rebases the current branch onto its new parent branch, removing the commits of its old parent branch from it, and force-pushes the current branch if it has a tracking branch.`
+
 func setParentCommand() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addRebaseFlag, readRebaseFlag := flags.Bool("rebase", "r", "Rebase the current branch onto its new parent", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "set-parent",
 		GroupID: "lineage",
 		Args:    cobra.NoArgs,
 		Short:   setParentDesc,
-		Long:    cmdhelpers.Long(setParentDesc),
+		Long:    cmdhelpers.Long(setParentDesc, setParentHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSetParent(readVerboseFlag(cmd))
+			return executeSetParent(readRebaseFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addRebaseFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeSetParent(verbose bool) error {
+func executeSetParent(rebase, verbose bool) error {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
@@ -44,7 +56,7 @@ func executeSetParent(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	branchesSnapshot, _, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
 		Fetch:                 false,
 		FullConfig:            &repo.Runner.Config.FullConfig,
@@ -73,11 +85,85 @@ func executeSetParent(verbose bool) error {
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    existingParent,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     false, // the dialog preselects the existing parent
 		Runner:           repo.Runner,
+		Yes:              false,
 	})
 	if err != nil {
 		return err
 	}
-	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
-	return nil
+	newParent := repo.Runner.Config.FullConfig.Lineage.Parent(branchesSnapshot.Active)
+	if !rebase || newParent.IsEmpty() || newParent == existingParent {
+		print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
+		return nil
+	}
+	initialBranch := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	if initialBranch == nil {
+		return fmt.Errorf(messages.BranchDoesntExist, branchesSnapshot.Active)
+	}
+	remotes, err := repo.Runner.Backend.Remotes()
+	if err != nil {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        stashSize,
+		Command:               "set-parent",
+		DryRun:                false,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram: setParentProgram(setParentProgramArgs{
+			FullConfig:     &repo.Runner.Config.FullConfig,
+			hasOpenChanges: repoStatus.OpenChanges,
+			initialBranch:  *initialBranch,
+			newParent:      newParent,
+			oldParent:      existingParent,
+			previousBranch: repo.Runner.Backend.PreviouslyCheckedOutBranch(),
+			remotes:        remotes,
+		}),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &dialogTestInputs,
+		FullConfig:              &repo.Runner.Config.FullConfig,
+		HasOpenChanges:          repoStatus.OpenChanges,
+		InitialBranchesSnapshot: branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        stashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type setParentProgramArgs struct {
+	*configdomain.FullConfig
+	hasOpenChanges bool
+	initialBranch  gitdomain.BranchInfo
+	newParent      gitdomain.LocalBranchName
+	oldParent      gitdomain.LocalBranchName
+	previousBranch gitdomain.LocalBranchName
+	remotes        gitdomain.Remotes
+}
+
+// setParentProgram rebases the initial branch from its old parent onto its new parent.
+func setParentProgram(args setParentProgramArgs) program.Program {
+	prog := program.Program{}
+	prog.Add(&opcodes.RebaseOnto{
+		BranchToRebaseOnto: args.newParent.BranchName(),
+		CommitsToRemove:    args.oldParent.Location(),
+	})
+	if args.initialBranch.HasTrackingBranch() && args.remotes.HasOrigin() && args.IsOnline() {
+		prog.Add(&opcodes.ForcePushCurrentBranch{})
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   false,
+		RunInGitRoot:             true,
+		StashOpenChanges:         args.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{args.previousBranch},
+	})
+	return prog
 }
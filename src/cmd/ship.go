@@ -56,6 +56,7 @@ func shipCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addMessageFlag, readMessageFlag := flags.CommitMessage("Specify the commit message for the squash commit")
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
 	cmd := cobra.Command{
 		Use:     "ship",
 		GroupID: "basic",
@@ -63,16 +64,17 @@ func shipCmd() *cobra.Command {
 		Short:   shipDesc,
 		Long:    cmdhelpers.Long(shipDesc, fmt.Sprintf(shipHelp, gitconfig.KeyGithubToken, gitconfig.KeyShipDeleteTrackingBranch)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeShip(args, readMessageFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
 	addDryRunFlag(&cmd)
 	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
 	addMessageFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message gitdomain.CommitMessage, dryRun, verbose bool) error {
+func executeShip(args []string, message gitdomain.CommitMessage, dryRun, verbose, yes bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -84,7 +86,7 @@ func executeShip(args []string, message gitdomain.CommitMessage, dryRun, verbose
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, dryRun, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineShipConfig(args, repo, dryRun, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
@@ -144,7 +146,7 @@ type shipConfig struct {
 	targetBranch             gitdomain.BranchInfo
 }
 
-func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*shipConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -183,7 +185,9 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 		AllBranches:      branchesSnapshot.Branches,
 		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
 		Runner:           repo.Runner,
+		Yes:              yes,
 	})
 	if err != nil {
 		return nil, branchesSnapshot, stashSize, false, err
@@ -219,6 +223,10 @@ func determineShipConfig(args []string, repo *execute.OpenRepoResult, dryRun, ve
 				return nil, branchesSnapshot, stashSize, false, err
 			}
 			if proposal != nil {
+				err = validateShippableProposal(branchNameToShip, *proposal)
+				if err != nil {
+					return nil, branchesSnapshot, stashSize, false, err
+				}
 				canShipViaAPI = true
 				proposalMessage = connector.DefaultProposalMessage(*proposal)
 			}
@@ -274,6 +282,7 @@ func shipProgram(config *shipConfig, commitMessage gitdomain.CommitMessage) prog
 			BranchInfos:   config.allBranches,
 			InitialBranch: config.initialBranch,
 			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
 			Program:       &prog,
 			PushBranch:    true,
 		})
@@ -283,6 +292,7 @@ func shipProgram(config *shipConfig, commitMessage gitdomain.CommitMessage) prog
 			BranchInfos:   config.allBranches,
 			InitialBranch: config.initialBranch,
 			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
 			Program:       &prog,
 			PushBranch:    false,
 		})
@@ -295,6 +305,7 @@ func shipProgram(config *shipConfig, commitMessage gitdomain.CommitMessage) prog
 			prog.Add(&opcodes.UpdateProposalTarget{
 				ProposalNumber: childProposal.Number,
 				NewTarget:      config.targetBranch.LocalName,
+				OldTarget:      gitdomain.EmptyLocalBranchName(),
 			})
 		}
 		prog.Add(&opcodes.PushCurrentBranch{CurrentBranch: config.branchToShip.LocalName})
@@ -324,7 +335,13 @@ func shipProgram(config *shipConfig, commitMessage gitdomain.CommitMessage) prog
 	if !config.dryRun {
 		prog.Add(&opcodes.DeleteParentBranch{Branch: config.branchToShip.LocalName})
 	}
+	shippedSHA := config.branchToShip.LocalSHA
+	if shippedSHA.IsEmpty() {
+		shippedSHA = config.branchToShip.RemoteSHA
+	}
 	for _, child := range config.childBranches {
+		// the child branches still contain the unsquashed commits of the shipped branch, their next sync removes them
+		prog.Add(&opcodes.SetPreviousParentSHA{Branch: child, SHA: shippedSHA})
 		prog.Add(&opcodes.ChangeParent{Branch: child, Parent: config.targetBranch.LocalName})
 	}
 	if !config.isShippingInitialBranch {
@@ -339,11 +356,27 @@ func shipProgram(config *shipConfig, commitMessage gitdomain.CommitMessage) prog
 	return prog
 }
 
+// validateShippableProposal ensures that the hosting platform would accept merging the given proposal,
+// so that ship fails upfront with a clear message instead of inside the API call.
+func validateShippableProposal(branch gitdomain.LocalBranchName, proposal hostingdomain.Proposal) error {
+	switch {
+	case proposal.Draft:
+		return fmt.Errorf(messages.ShipProposalDraft, branch, proposal.Number)
+	case proposal.Checks == hostingdomain.ChecksStatusFailing:
+		return fmt.Errorf(messages.ShipProposalChecksFailing, branch, proposal.Number)
+	case proposal.ReviewDecision == hostingdomain.ReviewDecisionChangesRequested:
+		return fmt.Errorf(messages.ShipProposalNeedsChanges, branch, proposal.Number)
+	case proposal.ReviewDecision == hostingdomain.ReviewDecisionReviewRequired:
+		return fmt.Errorf(messages.ShipProposalNotApproved, branch, proposal.Number)
+	}
+	return nil
+}
+
 func validateShippableBranchType(branchType configdomain.BranchType) error {
 	switch branchType {
 	case configdomain.BranchTypeContributionBranch:
 		return errors.New(messages.ContributionBranchCannotShip)
-	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
 		return nil
 	case configdomain.BranchTypeMainBranch:
 		return errors.New(messages.MainBranchCannotShip)
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog"
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const splitDesc = "Moves the first commits of the current branch into a new parent branch"
+
+const splitHelp = `
+Asks for the last commit that should move out of the current feature branch, creates a new feature branch with the given name that contains this commit and all commits before it, and makes the new branch the parent of the current branch. The current branch keeps its remaining commits on top of the new branch.
+
+If the current branch has a tracking branch, pushes the new branch to origin and updates the proposal of the current branch to target the new branch.
+Branches must be synced before you split them.`
+
+func splitCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
+	cmd := cobra.Command{
+		Use:     "split <branch>",
+		GroupID: "lineage",
+		Args:    cobra.ExactArgs(1),
+		Short:   splitDesc,
+		Long:    cmdhelpers.Long(splitDesc, splitHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeSplit(args[0], readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	addYesFlag(&cmd)
+	return &cmd
+}
+
+func executeSplit(arg string, dryRun, verbose, yes bool) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSplitConfig(gitdomain.NewLocalBranchName(arg), repo, dryRun, verbose, yes)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "split",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            splitProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               config.connector,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type splitConfig struct {
+	*configdomain.FullConfig
+	connector        hostingdomain.Connector
+	dialogTestInputs components.TestInputs
+	dryRun           bool
+	hasOpenChanges   bool
+	initialBranch    gitdomain.BranchInfo
+	parentBranch     gitdomain.LocalBranchName
+	previousBranch   gitdomain.LocalBranchName
+	proposal         *hostingdomain.Proposal // the proposal of the initial branch
+	remotes          gitdomain.Remotes
+	splitCommit      gitdomain.Commit // the last commit that goes into the new branch
+	targetBranch     gitdomain.LocalBranchName
+}
+
+func determineSplitConfig(targetBranch gitdomain.LocalBranchName, repo *execute.OpenRepoResult, dryRun, verbose, yes bool) (*splitConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	remotes := fc.Remotes(repo.Runner.Backend.Remotes())
+	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
+	}
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch) {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
+	}
+	initialBranch := branchesSnapshot.Branches.FindByLocalName(branchesSnapshot.Active)
+	if initialBranch == nil {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.BranchDoesntExist, branchesSnapshot.Active)
+	}
+	err = validateSplitBranch(*initialBranch, repo.Runner.Config.FullConfig.BranchType(initialBranch.LocalName))
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	err = execute.EnsureKnownBranchAncestry(initialBranch.LocalName, execute.EnsureKnownBranchAncestryArgs{
+		Config:           &repo.Runner.Config.FullConfig,
+		AllBranches:      branchesSnapshot.Branches,
+		DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
+		DialogTestInputs: &dialogTestInputs,
+		InferParents:     true,
+		Runner:           repo.Runner,
+		Yes:              yes,
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	parentBranch := repo.Runner.Config.FullConfig.Lineage.Parent(initialBranch.LocalName)
+	commits, err := repo.Runner.Backend.CommitsInBranch(initialBranch.LocalName, parentBranch)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	if len(commits) < 2 {
+		return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.SplitNotEnoughCommits, initialBranch.LocalName)
+	}
+	splitCommit, aborted, err := dialog.SplitCommit(initialBranch.LocalName, targetBranch, commits, dialogTestInputs.Next())
+	if err != nil || aborted {
+		return nil, branchesSnapshot, stashSize, aborted, err
+	}
+	var proposal *hostingdomain.Proposal
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &repo.Runner.Config.FullConfig,
+		HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       repo.Runner.Config.OriginURL(),
+	})
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	if !repo.IsOffline && connector != nil {
+		if initialBranch.HasTrackingBranch() {
+			proposal, err = connector.FindProposal(initialBranch.LocalName, parentBranch)
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, fmt.Errorf(messages.ProposalNotFoundForBranch, initialBranch.LocalName, err)
+			}
+		}
+	}
+	return &splitConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    *initialBranch,
+		parentBranch:     parentBranch,
+		previousBranch:   previousBranch,
+		proposal:         proposal,
+		remotes:          remotes,
+		splitCommit:      splitCommit,
+		targetBranch:     targetBranch,
+	}, branchesSnapshot, stashSize, false, fc.Err
+}
+
+func splitProgram(config *splitConfig) program.Program {
+	prog := program.Program{}
+	prog.Add(&opcodes.CreateBranch{
+		Branch:        config.targetBranch,
+		StartingPoint: config.splitCommit.SHA.Location(),
+	})
+	prog.Add(&opcodes.SetParent{
+		Branch: config.targetBranch,
+		Parent: config.parentBranch,
+	})
+	prog.Add(&opcodes.ChangeParent{
+		Branch: config.initialBranch.LocalName,
+		Parent: config.targetBranch,
+	})
+	if config.initialBranch.HasTrackingBranch() && config.remotes.HasOrigin() && config.IsOnline() {
+		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
+		if config.proposal != nil {
+			prog.Add(&opcodes.UpdateProposalTarget{
+				NewTarget:      config.targetBranch,
+				OldTarget:      config.proposal.Target,
+				ProposalNumber: config.proposal.Number,
+			})
+		}
+	}
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.previousBranch},
+	})
+	return prog
+}
+
+func validateSplitBranch(branch gitdomain.BranchInfo, branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+		return errors.New(messages.SplitIsPerennial)
+	case configdomain.BranchTypeObservedBranch:
+		return fmt.Errorf(messages.SplitObservedBranch, branch.LocalName)
+	case configdomain.BranchTypeContributionBranch:
+		return fmt.Errorf(messages.SplitContributionBranch, branch.LocalName)
+	}
+	if branch.SyncStatus != gitdomain.SyncStatusUpToDate && branch.SyncStatus != gitdomain.SyncStatusLocalOnly {
+		return fmt.Errorf(messages.SplitUnsynced, branch.LocalName)
+	}
+	return nil
+}
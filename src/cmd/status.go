@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/jsonoutput"
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v13/src/execute"
@@ -18,6 +19,7 @@ import (
 const statusDesc = "Displays or resets the current suspended Git Town command"
 
 func statusCommand() *cobra.Command {
+	addJSONFlag, readJSONFlag := flags.JSON()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "status",
@@ -26,15 +28,16 @@ func statusCommand() *cobra.Command {
 		Short:   statusDesc,
 		Long:    cmdhelpers.Long(statusDesc),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeStatus(readVerboseFlag(cmd))
+			return executeStatus(readJSONFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addJSONFlag(&cmd)
 	addVerboseFlag(&cmd)
 	cmd.AddCommand(resetRunstateCommand())
 	return &cmd
 }
 
-func executeStatus(verbose bool) error {
+func executeStatus(json, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -50,6 +53,9 @@ func executeStatus(verbose bool) error {
 	if err != nil {
 		return err
 	}
+	if json {
+		return jsonoutput.Print(jsonoutput.NewStatus(config.filepath, config.state))
+	}
 	displayStatus(*config)
 	print.Footer(verbose, repo.Runner.CommandsCounter.Count(), print.NoFinalMessages)
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v13/src/execute"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/subshell"
 	"github.com/spf13/cobra"
 )
 
@@ -48,7 +49,20 @@ func executeSwitch(verbose bool) error {
 	if err != nil || exit {
 		return err
 	}
-	branchToCheckout, abort, err := dialog.SwitchBranch(config.branchNames, config.initialBranch, repo.Runner.Config.FullConfig.Lineage)
+	// the preview must not print the Git commands it runs since that would interfere with the dialog
+	previewBackend := repo.Runner.Backend
+	previewBackend.Runner = subshell.BackendRunner{
+		CommandsCounter: repo.Runner.CommandsCounter,
+		Dir:             nil,
+		Output:          nil,
+		Verbose:         false,
+	}
+	branchToCheckout, abort, err := dialog.SwitchBranch(dialog.SwitchBranchArgs{
+		Branches:      config.branches,
+		Config:        &repo.Runner.Config.FullConfig,
+		InitialBranch: config.initialBranch,
+		LoadCommits:   previewBackend.CommitsInFeatureBranch,
+	})
 	if err != nil || abort {
 		return err
 	}
@@ -68,7 +82,7 @@ func executeSwitch(verbose bool) error {
 }
 
 type switchConfig struct {
-	branchNames   gitdomain.LocalBranchNames
+	branches      gitdomain.BranchInfos
 	initialBranch gitdomain.LocalBranchName
 }
 
@@ -88,7 +102,7 @@ func determineSwitchConfig(repo *execute.OpenRepoResult, verbose bool) (*switchC
 		return nil, exit, err
 	}
 	return &switchConfig{
-		branchNames:   branchesSnapshot.Branches.Names(),
+		branches:      branchesSnapshot.Branches.LocalBranches(),
 		initialBranch: branchesSnapshot.Active,
 	}, false, err
 }
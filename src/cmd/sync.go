@@ -1,19 +1,27 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/git-town/git-town/v13/src/cli/dialog/components"
 	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/config/gitconfig"
 	"github.com/git-town/git-town/v13/src/execute"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/subshell"
 	"github.com/git-town/git-town/v13/src/sync"
 	"github.com/git-town/git-town/v13/src/undo/undoconfig"
 	fullInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
 	"github.com/git-town/git-town/v13/src/vm/program"
 	"github.com/git-town/git-town/v13/src/vm/runstate"
 	"github.com/spf13/cobra"
@@ -34,12 +42,16 @@ When run on the main branch or a perennial branch:
 - pulls and pushes updates for the current branch
 - pushes tags
 
+With the --stack flag, also syncs all descendants of the current branch, i.e. the entire stack that the current branch belongs to. Branches in other stacks remain untouched.
+
 If the repository contains an "upstream" remote, syncs the main branch with its upstream counterpart. You can disable this by running "git config %s false".`
 
 func syncCmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addYesFlag, readYesFlag := flags.Yes()
 	addAllFlag, readAllFlag := flags.Bool("all", "a", "Sync all local branches", flags.FlagTypeNonPersistent)
+	addStackFlag, readStackFlag := flags.Bool("stack", "s", "Sync the ancestors and descendants of the current branch", flags.FlagTypeNonPersistent)
 	cmd := cobra.Command{
 		Use:     "sync",
 		GroupID: "basic",
@@ -47,16 +59,21 @@ func syncCmd() *cobra.Command {
 		Short:   syncDesc,
 		Long:    cmdhelpers.Long(syncDesc, fmt.Sprintf(syncHelp, gitconfig.KeySyncUpstream)),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeSync(readAllFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd))
+			return executeSync(readAllFlag(cmd), readStackFlag(cmd), readDryRunFlag(cmd), readVerboseFlag(cmd), readYesFlag(cmd))
 		},
 	}
 	addAllFlag(&cmd)
+	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addYesFlag(&cmd)
 	return &cmd
 }
 
-func executeSync(all, dryRun, verbose bool) error {
+func executeSync(all, stack, dryRun, verbose, yes bool) error {
+	if all && stack {
+		return errors.New(messages.SyncAllAndStack)
+	}
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           dryRun,
 		OmitBranchNames:  false,
@@ -68,11 +85,14 @@ func executeSync(all, dryRun, verbose bool) error {
 	if err != nil {
 		return err
 	}
-	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(all, repo, verbose)
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineSyncConfig(all, stack, repo, verbose, yes)
 	if err != nil || exit {
 		return err
 	}
 	runProgram := program.Program{}
+	for _, message := range config.worktreeMessages {
+		runProgram.Add(&opcodes.QueueMessage{Message: message})
+	}
 	sync.BranchesProgram(sync.BranchesProgramArgs{
 		BranchProgramArgs: sync.BranchProgramArgs{
 			Config:        config.FullConfig,
@@ -81,6 +101,7 @@ func executeSync(all, dryRun, verbose bool) error {
 			Remotes:       config.remotes,
 			Program:       &runProgram,
 			PushBranch:    true,
+			Worktrees:     config.worktrees,
 		},
 		BranchesToSync: config.branchesToSync,
 		DryRun:         dryRun,
@@ -89,6 +110,9 @@ func executeSync(all, dryRun, verbose bool) error {
 		PreviousBranch: config.previousBranch,
 		ShouldPushTags: config.shouldPushTags,
 	})
+	if config.connector != nil {
+		runProgram.Add(&opcodes.UpdateProposalStacks{Branches: config.branchesToSync})
+	}
 	runProgram.RemoveDuplicateCheckout()
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: initialBranchesSnapshot,
@@ -102,7 +126,7 @@ func executeSync(all, dryRun, verbose bool) error {
 		RunProgram:            runProgram,
 	}
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
-		Connector:               nil,
+		Connector:               config.connector,
 		DialogTestInputs:        &config.dialogTestInputs,
 		FullConfig:              config.FullConfig,
 		HasOpenChanges:          config.hasOpenChanges,
@@ -120,15 +144,18 @@ type syncConfig struct {
 	*configdomain.FullConfig
 	allBranches      gitdomain.BranchInfos
 	branchesToSync   gitdomain.BranchInfos
+	connector        hostingdomain.Connector
 	dialogTestInputs components.TestInputs
 	hasOpenChanges   bool
 	initialBranch    gitdomain.LocalBranchName
 	previousBranch   gitdomain.LocalBranchName
 	remotes          gitdomain.Remotes
 	shouldPushTags   bool
+	worktreeMessages []string            // messages about the other worktrees in which Git Town doesn't sync
+	worktrees        gitdomain.Worktrees // the other worktrees in which Git Town syncs the branches they have checked out
 }
 
-func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+func determineSyncConfig(allFlag, stackFlag bool, repo *execute.OpenRepoResult, verbose, yes bool) (*syncConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
 		DialogTestInputs:      dialogTestInputs,
@@ -157,6 +184,7 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 			DialogTestInputs: &dialogTestInputs,
 			LocalBranches:    localBranches,
 			Runner:           repo.Runner,
+			Yes:              yes,
 		})
 		if err != nil {
 			return nil, branchesSnapshot, stashSize, false, err
@@ -169,25 +197,107 @@ func determineSyncConfig(allFlag bool, repo *execute.OpenRepoResult, verbose boo
 			AllBranches:      branchesSnapshot.Branches,
 			DefaultBranch:    repo.Runner.Config.FullConfig.MainBranch,
 			DialogTestInputs: &dialogTestInputs,
+			InferParents:     true,
 			Runner:           repo.Runner,
+			Yes:              yes,
 		})
 		if err != nil {
 			return nil, branchesSnapshot, stashSize, false, err
 		}
-		branchNamesToSync = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		if stackFlag {
+			branchNamesToSync = stackBranches(branchesSnapshot.Active, repo.Runner.Config.FullConfig.Lineage, branchesSnapshot.Branches)
+		} else {
+			branchNamesToSync = gitdomain.LocalBranchNames{branchesSnapshot.Active}
+		}
 		shouldPushTags = repo.Runner.Config.FullConfig.IsMainOrPerennialBranch(branchesSnapshot.Active)
 	}
+	// if enabled, the connector refreshes the stack sections of proposals at the end of the sync
+	var connector hostingdomain.Connector
+	if repo.Runner.Config.FullConfig.SyncProposalStacks.Bool() && !repo.IsOffline.Bool() && len(proposalstack.Stacks(branchNamesToSync, repo.Runner.Config.FullConfig.Lineage)) > 0 {
+		originURL := repo.Runner.Config.OriginURL()
+		if originURL != nil {
+			connector, err = hosting.NewConnector(hosting.NewConnectorArgs{
+				FullConfig:      &repo.Runner.Config.FullConfig,
+				HostingPlatform: repo.Runner.Config.FullConfig.HostingPlatform,
+				Log:             print.Logger{},
+				OriginURL:       originURL,
+			})
+			if err != nil {
+				return nil, branchesSnapshot, stashSize, false, err
+			}
+		}
+	}
 	allBranchNamesToSync := repo.Runner.Config.FullConfig.Lineage.BranchesAndAncestors(branchNamesToSync)
 	branchesToSync, err := branchesSnapshot.Branches.Select(allBranchNamesToSync)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	worktrees, worktreeMessages, err := syncableWorktrees(branchesToSync, repo, verbose)
 	return &syncConfig{
 		FullConfig:       &repo.Runner.Config.FullConfig,
 		allBranches:      branchesSnapshot.Branches,
 		branchesToSync:   branchesToSync,
+		connector:        connector,
 		dialogTestInputs: dialogTestInputs,
 		hasOpenChanges:   repoStatus.OpenChanges,
 		initialBranch:    branchesSnapshot.Active,
 		previousBranch:   previousBranch,
 		remotes:          remotes,
 		shouldPushTags:   shouldPushTags,
+		worktreeMessages: worktreeMessages,
+		worktrees:        worktrees,
 	}, branchesSnapshot, stashSize, false, err
 }
+
+// stackBranches provides the local branches in the stack of the given branch:
+// the branch itself, its ancestors, and its descendants, ordered hierarchically.
+func stackBranches(branch gitdomain.LocalBranchName, lineage configdomain.Lineage, allBranches gitdomain.BranchInfos) gitdomain.LocalBranchNames {
+	result := lineage.Ancestors(branch)
+	for _, descendant := range lineage.BranchAndDescendants(branch) {
+		if allBranches.HasLocalBranch(descendant) {
+			result = append(result, descendant)
+		}
+	}
+	lineage.OrderHierarchically(result)
+	return result
+}
+
+// syncableWorktrees provides the other worktrees in which Git Town can sync the given branches,
+// i.e. the worktrees without uncommitted changes,
+// as well as messages about the worktrees that Git Town cannot use.
+func syncableWorktrees(branches gitdomain.BranchInfos, repo *execute.OpenRepoResult, verbose bool) (gitdomain.Worktrees, []string, error) {
+	result := gitdomain.Worktrees{}
+	messageList := []string{}
+	otherWorktreeBranches := branches.OtherWorktreeBranches()
+	if len(otherWorktreeBranches) == 0 {
+		return result, messageList, nil
+	}
+	worktrees, err := repo.Runner.Backend.Worktrees()
+	if err != nil {
+		return result, messageList, err
+	}
+	for _, branch := range otherWorktreeBranches {
+		worktree := worktrees.FindByBranch(branch)
+		if worktree == nil {
+			continue
+		}
+		dir := worktree.Dir.String()
+		worktreeBackend := repo.Runner.Backend
+		worktreeBackend.Runner = subshell.BackendRunner{
+			CommandsCounter: repo.Runner.CommandsCounter,
+			Dir:             &dir,
+			Output:          nil,
+			Verbose:         verbose,
+		}
+		repoStatus, err := worktreeBackend.RepoStatus()
+		if err != nil {
+			return result, messageList, err
+		}
+		if repoStatus.OpenChanges {
+			messageList = append(messageList, fmt.Sprintf(messages.SyncWorktreeOpenChanges, branch, worktree.Dir))
+			continue
+		}
+		result = append(result, *worktree)
+	}
+	return result, messageList, nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -15,29 +16,37 @@ import (
 	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v13/src/messages"
 	"github.com/git-town/git-town/v13/src/undo"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
 	"github.com/git-town/git-town/v13/src/vm/statefile"
 	"github.com/spf13/cobra"
 )
 
 const undoDesc = "Undoes the most recent Git Town command"
 
+const undoHelp = `
+With the --steps option, undoes the given number of most recent Git Town commands.
+This is only possible if the branches didn't change in between these commands.
+Run "git town history" to see which commands can be undone.`
+
 func undoCmd() *cobra.Command {
+	addStepsFlag, readStepsFlag := flags.Int("steps", "", 1, "Number of most recent Git Town commands to undo", flags.FlagTypeNonPersistent)
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "undo",
 		GroupID: "errors",
 		Args:    cobra.NoArgs,
 		Short:   undoDesc,
-		Long:    cmdhelpers.Long(undoDesc),
+		Long:    cmdhelpers.Long(undoDesc, undoHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeUndo(readVerboseFlag(cmd))
+			return executeUndo(readStepsFlag(cmd), readVerboseFlag(cmd))
 		},
 	}
+	addStepsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeUndo(verbose bool) error {
+func executeUndo(steps int, verbose bool) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
 		DryRun:           false,
 		OmitBranchNames:  false,
@@ -55,24 +64,64 @@ func executeUndo(verbose bool) error {
 	if err != nil {
 		return err
 	}
-	runState, err := statefile.Load(repo.RootDir)
+	history, err := statefile.LoadHistory(repo.RootDir)
 	if err != nil {
 		return fmt.Errorf(messages.RunstateLoadProblem, err)
 	}
-	if runState == nil {
+	if len(history) == 0 {
 		fmt.Println(messages.UndoNothingToDo)
 		return nil
 	}
-	return undo.Execute(undo.ExecuteArgs{
-		FullConfig:       config.FullConfig,
-		HasOpenChanges:   config.hasOpenChanges,
-		InitialStashSize: initialStashSize,
-		Lineage:          repo.Runner.Config.FullConfig.Lineage,
-		RootDir:          repo.RootDir,
-		RunState:         *runState,
-		Runner:           repo.Runner,
-		Verbose:          verbose,
-	})
+	err = validateUndoSteps(steps, history)
+	if err != nil {
+		return err
+	}
+	for e, entry := range history[:steps] {
+		if e > 0 {
+			// the previous undo has changed the repo, so reload its state
+			repo.Runner.Config.Reload()
+			var repoStatus gitdomain.RepoStatus
+			repoStatus, err = repo.Runner.Backend.RepoStatus()
+			if err != nil {
+				return err
+			}
+			config.hasOpenChanges = repoStatus.OpenChanges
+			initialStashSize, err = repo.Runner.Backend.StashSize()
+			if err != nil {
+				return err
+			}
+		}
+		err = undo.Execute(undo.ExecuteArgs{
+			Connector:        config.connector,
+			FullConfig:       config.FullConfig,
+			HasOpenChanges:   config.hasOpenChanges,
+			InitialStashSize: initialStashSize,
+			Lineage:          repo.Runner.Config.FullConfig.Lineage,
+			RootDir:          repo.RootDir,
+			RunState:         entry.RunState,
+			Runner:           repo.Runner,
+			Verbose:          verbose,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateUndoSteps ensures that the given number of commands can be undone safely.
+func validateUndoSteps(steps int, history runstate.History) error {
+	if steps < 1 {
+		return errors.New(messages.UndoStepsInvalid)
+	}
+	if steps > len(history) {
+		return fmt.Errorf(messages.UndoStepsTooMany, steps, len(history))
+	}
+	undoableCount := history.UndoableCount()
+	if steps > undoableCount {
+		return fmt.Errorf(messages.UndoStepsUnsafe, steps, undoableCount)
+	}
+	return nil
 }
 
 type undoConfig struct {
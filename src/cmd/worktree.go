@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/flags"
+	"github.com/git-town/git-town/v13/src/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/execute"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/sync"
+	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	fullInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/full"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/spf13/cobra"
+)
+
+const worktreeDesc = "Creates a new feature branch off the main development branch in a new worktree"
+
+const worktreeHelp = `
+Syncs the main branch, forks a new feature branch with the given name off the main branch, checks out the new feature branch in a new worktree at the given directory, and pushes the new feature branch to origin (if and only if "push-new-branches" is true).
+
+If no directory is given, creates the worktree next to the current repository, in a directory named after the repository and the new branch.
+The current workspace and its uncommitted changes remain untouched.
+
+"git sync" syncs the branches checked out in other worktrees inside their worktree, as long as that worktree has no uncommitted changes.`
+
+func worktreeCmd() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	cmd := cobra.Command{
+		Use:     "worktree <branch> [<directory>]",
+		GroupID: "basic",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   worktreeDesc,
+		Long:    cmdhelpers.Long(worktreeDesc, worktreeHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWorktree(args, readDryRunFlag(cmd), readVerboseFlag(cmd))
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeWorktree(args []string, dryRun, verbose bool) error {
+	// resolve the given directory before Git Town changes into the root directory of the repo
+	dir := ""
+	if len(args) == 2 {
+		var err error
+		dir, err = filepath.Abs(args[1])
+		if err != nil {
+			return err
+		}
+	}
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:           dryRun,
+		OmitBranchNames:  false,
+		PrintCommands:    true,
+		ValidateGitRepo:  true,
+		ValidateIsOnline: false,
+		Verbose:          verbose,
+	})
+	if err != nil {
+		return err
+	}
+	config, initialBranchesSnapshot, initialStashSize, exit, err := determineWorktreeConfig(gitdomain.NewLocalBranchName(args[0]), dir, repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: initialBranchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        initialStashSize,
+		Command:               "worktree",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   gitdomain.EmptyBranchesSnapshot(),
+		EndConfigSnapshot:     undoconfig.EmptyConfigSnapshot(),
+		EndStashSize:          0,
+		RunProgram:            worktreeProgram(config),
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Connector:               nil,
+		DialogTestInputs:        &config.dialogTestInputs,
+		FullConfig:              config.FullConfig,
+		HasOpenChanges:          config.hasOpenChanges,
+		InitialBranchesSnapshot: initialBranchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        initialStashSize,
+		RootDir:                 repo.RootDir,
+		Run:                     repo.Runner,
+		RunState:                &runState,
+		Verbose:                 verbose,
+	})
+}
+
+type worktreeConfig struct {
+	*configdomain.FullConfig
+	allBranches      gitdomain.BranchInfos
+	branchesToSync   gitdomain.BranchInfos
+	dialogTestInputs components.TestInputs
+	dir              string // the directory of the new worktree, relative to the root directory of the repo
+	dryRun           bool
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   gitdomain.LocalBranchName
+	remotes          gitdomain.Remotes
+	targetBranch     gitdomain.LocalBranchName
+}
+
+func determineWorktreeConfig(targetBranch gitdomain.LocalBranchName, dir string, repo *execute.OpenRepoResult, dryRun, verbose bool) (*worktreeConfig, gitdomain.BranchesSnapshot, gitdomain.StashSize, bool, error) {
+	fc := execute.FailureCollector{}
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	branchesSnapshot, stashSize, repoStatus, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FullConfig:            &repo.Runner.Config.FullConfig,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		ValidateIsConfigured:  true,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return nil, branchesSnapshot, stashSize, exit, err
+	}
+	previousBranch := repo.Runner.Backend.PreviouslyCheckedOutBranch()
+	remotes := fc.Remotes(repo.Runner.Backend.Remotes())
+	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
+		fc.Fail(messages.BranchAlreadyExistsLocally, targetBranch)
+	}
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch) {
+		fc.Fail(messages.BranchAlreadyExistsRemotely, targetBranch)
+	}
+	rootDir := repo.RootDir.String()
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(rootDir), filepath.Base(rootDir)+"-"+strings.ReplaceAll(targetBranch.String(), "/", "-"))
+	}
+	relativeDir, err := filepath.Rel(rootDir, dir)
+	if err != nil {
+		return nil, branchesSnapshot, stashSize, false, err
+	}
+	branchNamesToSync := gitdomain.LocalBranchNames{repo.Runner.Config.FullConfig.MainBranch}
+	branchesToSync := fc.BranchInfos(branchesSnapshot.Branches.Select(branchNamesToSync))
+	return &worktreeConfig{
+		FullConfig:       &repo.Runner.Config.FullConfig,
+		allBranches:      branchesSnapshot.Branches,
+		branchesToSync:   branchesToSync,
+		dialogTestInputs: dialogTestInputs,
+		dir:              relativeDir,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    branchesSnapshot.Active,
+		previousBranch:   previousBranch,
+		remotes:          remotes,
+		targetBranch:     targetBranch,
+	}, branchesSnapshot, stashSize, false, fc.Err
+}
+
+func worktreeProgram(config *worktreeConfig) program.Program {
+	prog := program.Program{}
+	for _, branch := range config.branchesToSync {
+		sync.BranchProgram(branch, sync.BranchProgramArgs{
+			Config:        config.FullConfig,
+			BranchInfos:   config.allBranches,
+			InitialBranch: config.initialBranch,
+			Program:       &prog,
+			Remotes:       config.remotes,
+			Worktrees:     gitdomain.Worktrees{},
+			PushBranch:    true,
+		})
+	}
+	prog.Add(&opcodes.CreateWorktree{
+		Branch:        config.targetBranch,
+		Dir:           config.dir,
+		StartingPoint: config.MainBranch.Location(),
+	})
+	prog.Add(&opcodes.SetParent{
+		Branch: config.targetBranch,
+		Parent: config.MainBranch,
+	})
+	if config.remotes.HasOrigin() && config.ShouldPushNewBranch(config.targetBranch) && config.IsOnline() {
+		prog.Add(&opcodes.EnterWorktree{Branch: config.targetBranch, Dir: gitdomain.EmptyRepoRootDir()})
+		prog.Add(&opcodes.CreateTrackingBranch{Branch: config.targetBranch})
+		prog.Add(&opcodes.LeaveWorktree{})
+	}
+	prog.Add(&opcodes.Checkout{Branch: config.initialBranch})
+	cmdhelpers.Wrap(&prog, cmdhelpers.WrapOptions{
+		DryRun:                   config.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         config.hasOpenChanges,
+		PreviousBranchCandidates: gitdomain.LocalBranchNames{config.initialBranch, config.previousBranch},
+	})
+	return prog
+}
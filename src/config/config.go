@@ -54,6 +54,12 @@ func (self *Config) AddToPerennialBranches(branches ...gitdomain.LocalBranchName
 	return self.SetPerennialBranches(append(self.FullConfig.PerennialBranches, branches...))
 }
 
+// AddToPrototypeBranches registers the given branch names as prototype branches.
+// The branches must exist.
+func (self *Config) AddToPrototypeBranches(branches ...gitdomain.LocalBranchName) error {
+	return self.SetPrototypeBranches(append(self.FullConfig.PrototypeBranches, branches...))
+}
+
 // OriginURL provides the URL for the "origin" remote.
 // Tests can stub this through the GIT_TOWN_REMOTE environment variable.
 // Caches its result so can be called repeatedly.
@@ -111,6 +117,12 @@ func (self *Config) RemoveFromPerennialBranches(branch gitdomain.LocalBranchName
 	return self.SetPerennialBranches(self.FullConfig.PerennialBranches)
 }
 
+// RemoveFromPrototypeBranches removes the given branch as a prototype branch.
+func (self *Config) RemoveFromPrototypeBranches(branch gitdomain.LocalBranchName) error {
+	self.FullConfig.PrototypeBranches = slice.Remove(self.FullConfig.PrototypeBranches, branch)
+	return self.SetPrototypeBranches(self.FullConfig.PrototypeBranches)
+}
+
 func (self *Config) RemoveMainBranch() {
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.KeyMainBranch)
 }
@@ -121,6 +133,19 @@ func (self *Config) RemoveParent(branch gitdomain.LocalBranchName) {
 		self.LocalGitConfig.Lineage.RemoveBranch(branch)
 	}
 	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.NewParentKey(branch))
+	self.RemovePreviousParentSHA(branch)
+}
+
+// RemovePreviousParentSHA removes the recorded SHA of the previous parent of the given branch.
+func (self *Config) RemovePreviousParentSHA(branch gitdomain.LocalBranchName) {
+	if _, has := self.FullConfig.PreviousParentSHAs[branch]; !has {
+		return
+	}
+	delete(self.FullConfig.PreviousParentSHAs, branch)
+	if self.LocalGitConfig.PreviousParentSHAs != nil {
+		delete(*self.LocalGitConfig.PreviousParentSHAs, branch)
+	}
+	_ = self.GitConfig.RemoveLocalConfigValue(gitconfig.NewPreviousParentSHAKey(branch))
 }
 
 func (self *Config) RemovePerennialBranches() {
@@ -205,6 +230,15 @@ func (self *Config) SetParent(branch, parentBranch gitdomain.LocalBranchName) er
 	return self.GitConfig.SetLocalConfigValue(gitconfig.NewParentKey(branch), parentBranch.String())
 }
 
+// SetPreviousParentSHA records the last SHA of the shipped or deleted previous parent of the given branch.
+func (self *Config) SetPreviousParentSHA(branch gitdomain.LocalBranchName, sha gitdomain.SHA) error {
+	if self.DryRun {
+		return nil
+	}
+	self.FullConfig.PreviousParentSHAs[branch] = sha
+	return self.GitConfig.SetLocalConfigValue(gitconfig.NewPreviousParentSHAKey(branch), sha.String())
+}
+
 // SetObservedBranches marks the given branches as perennial branches.
 func (self *Config) SetParkedBranches(branches gitdomain.LocalBranchNames) error {
 	self.FullConfig.ParkedBranches = branches
@@ -226,6 +260,13 @@ func (self *Config) SetPerennialRegexLocally(value configdomain.PerennialRegex)
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPerennialRegex, value.String())
 }
 
+// SetPrototypeBranches marks the given branches as prototype branches.
+func (self *Config) SetPrototypeBranches(branches gitdomain.LocalBranchNames) error {
+	self.FullConfig.PrototypeBranches = branches
+	self.LocalGitConfig.PrototypeBranches = &branches
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPrototypeBranches, branches.Join(" "))
+}
+
 // SetPushHook updates the configured push-hook strategy.
 func (self *Config) SetPushHookGlobally(value configdomain.PushHook) error {
 	self.GlobalGitConfig.PushHook = &value
@@ -253,6 +294,17 @@ func (self *Config) SetPushNewBranches(value configdomain.PushNewBranches, globa
 	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyPushNewBranches, setting)
 }
 
+// SetShareLineage updates whether Git Town shares the lineage with the other clones of this repository.
+func (self *Config) SetShareLineage(value configdomain.ShareLineage, global bool) error {
+	self.FullConfig.ShareLineage = value
+	if global {
+		self.GlobalGitConfig.ShareLineage = &value
+		return self.GitConfig.SetGlobalConfigValue(gitconfig.KeyShareLineage, strconv.FormatBool(value.Bool()))
+	}
+	self.LocalGitConfig.ShareLineage = &value
+	return self.GitConfig.SetLocalConfigValue(gitconfig.KeyShareLineage, strconv.FormatBool(value.Bool()))
+}
+
 // SetShipDeleteTrackingBranch updates the configured delete-tracking-branch strategy.
 func (self *Config) SetShipDeleteTrackingBranch(value configdomain.ShipDeleteTrackingBranch, global bool) error {
 	self.FullConfig.ShipDeleteTrackingBranch = value
@@ -312,6 +364,11 @@ func NewConfig(args NewConfigArgs) (*Config, *stringslice.Collector, error) {
 	config.Merge(args.LocalConfig)
 	configAccess := gitconfig.Access{Runner: args.Runner}
 	finalMessages := stringslice.Collector{}
+	for _, problem := range []error{config.PerennialRegex.Problem(), config.ContributionRegex.Problem(), config.ObservedRegex.Problem()} {
+		if problem != nil {
+			finalMessages.Add(fmt.Sprintf(messages.BranchTypeRegexIgnored, problem))
+		}
+	}
 	err := cleanupPerennialParentEntries(config.Lineage, config.MainAndPerennials(), configAccess, &finalMessages)
 	return &Config{
 		ConfigFile:      args.ConfigFile,
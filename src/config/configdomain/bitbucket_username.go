@@ -0,0 +1,13 @@
+package configdomain
+
+// BitbucketUsername is the name of the user to authenticate as with the Bitbucket API.
+type BitbucketUsername string
+
+func (self BitbucketUsername) String() string {
+	return string(self)
+}
+
+func NewBitbucketUsernameRef(value string) *BitbucketUsername {
+	username := BitbucketUsername(value)
+	return &username
+}
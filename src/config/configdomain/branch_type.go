@@ -1,6 +1,11 @@
 package configdomain
 
-import "github.com/git-town/git-town/v13/src/git/gitdomain"
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
 
 type BranchType int
 
@@ -11,14 +16,36 @@ const (
 	BranchTypeParkedBranch
 	BranchTypeContributionBranch
 	BranchTypeObservedBranch
+	BranchTypePrototypeBranch
 )
 
+// Name provides the name of this branch type as used in the configuration.
+func (self BranchType) Name() string {
+	switch self {
+	case BranchTypeMainBranch:
+		return "main"
+	case BranchTypePerennialBranch:
+		return "perennial"
+	case BranchTypeFeatureBranch:
+		return "feature"
+	case BranchTypeParkedBranch:
+		return "parked"
+	case BranchTypeContributionBranch:
+		return "contribution"
+	case BranchTypeObservedBranch:
+		return "observed"
+	case BranchTypePrototypeBranch:
+		return "prototype"
+	}
+	panic("unhandled branch type")
+}
+
 // ShouldPush indicates whether a branch with this type should push its local commit to origin.
 func (self BranchType) ShouldPush(currentBranch, initialBranch gitdomain.LocalBranchName) bool {
 	switch self {
 	case BranchTypeMainBranch, BranchTypeFeatureBranch, BranchTypePerennialBranch, BranchTypeContributionBranch:
 		return true
-	case BranchTypeObservedBranch:
+	case BranchTypeObservedBranch, BranchTypePrototypeBranch:
 		return false
 	case BranchTypeParkedBranch:
 		return currentBranch == initialBranch
@@ -40,6 +67,18 @@ func (self BranchType) String() string {
 		return "contribution branch"
 	case BranchTypeObservedBranch:
 		return "observed branch"
+	case BranchTypePrototypeBranch:
+		return "prototype branch"
 	}
 	panic("unhandled branch type")
 }
+
+// ParseBranchType provides the BranchType with the given name.
+func ParseBranchType(name string) (BranchType, error) {
+	for _, branchType := range []BranchType{BranchTypeMainBranch, BranchTypePerennialBranch, BranchTypeFeatureBranch, BranchTypeParkedBranch, BranchTypeContributionBranch, BranchTypeObservedBranch, BranchTypePrototypeBranch} {
+		if name == branchType.Name() {
+			return branchType, nil
+		}
+	}
+	return BranchTypeFeatureBranch, fmt.Errorf(messages.BranchTypeUnknown, name)
+}
@@ -0,0 +1,170 @@
+package configdomain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// BranchTypeRule assigns a branch type to all branches whose name matches a glob pattern or regular expression.
+// In glob patterns, "*" matches any characters including "/".
+type BranchTypeRule struct {
+	BranchType BranchType     // the branch type of the branches this rule applies to
+	Glob       bool           // whether Pattern is a glob pattern instead of a regular expression
+	Pattern    string         // matches the names of the branches this rule applies to
+	Regex      *regexp.Regexp // the compiled Pattern, glob patterns are compiled into an equivalent regular expression
+}
+
+// IsRegex indicates whether the pattern of this rule is a regular expression instead of a glob pattern.
+func (self BranchTypeRule) IsRegex() bool {
+	return !self.Glob
+}
+
+// MarshalJSON is used when serializing this BranchTypeRule to JSON.
+func (self BranchTypeRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(self.String())
+}
+
+// Matches indicates whether this rule applies to the given branch.
+func (self BranchTypeRule) Matches(branch gitdomain.LocalBranchName) bool {
+	return self.Regex.MatchString(branch.String())
+}
+
+// PatternString provides the pattern of this rule as written in the Git metadata:
+// regular expressions are enclosed in slashes.
+func (self BranchTypeRule) PatternString() string {
+	if self.IsRegex() {
+		return "/" + self.Pattern + "/"
+	}
+	return self.Pattern
+}
+
+// String provides this rule in the format used in the Git metadata.
+func (self BranchTypeRule) String() string {
+	return self.BranchType.Name() + "=" + self.PatternString()
+}
+
+// NewBranchTypeRule provides a BranchTypeRule that assigns the branch type with the given name
+// to the branches matching the given glob pattern or, if isRegex is set, regular expression.
+func NewBranchTypeRule(branchTypeName, pattern string, isRegex bool) (BranchTypeRule, error) {
+	branchType, err := ParseBranchType(branchTypeName)
+	if err != nil {
+		return BranchTypeRule{}, err //nolint:exhaustruct
+	}
+	if branchType == BranchTypeMainBranch {
+		return BranchTypeRule{}, errors.New(messages.BranchTypeRuleMainBranch) //nolint:exhaustruct
+	}
+	if pattern == "" {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleEmptyPattern, branchTypeName) //nolint:exhaustruct
+	}
+	var regex *regexp.Regexp
+	if isRegex {
+		regex, err = regexp.Compile(pattern)
+	} else {
+		regex, err = globRegex(pattern)
+	}
+	if err != nil {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleInvalidPattern, pattern, err) //nolint:exhaustruct
+	}
+	return BranchTypeRule{
+		BranchType: branchType,
+		Glob:       !isRegex,
+		Pattern:    pattern,
+		Regex:      regex,
+	}, nil
+}
+
+// globRegex provides a regular expression that matches the same branch names as the given glob pattern.
+// Unlike in file paths, "*" and "?" also match "/" because slashes in branch names only group branches by convention.
+func globRegex(pattern string) (*regexp.Regexp, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	result := strings.Builder{}
+	result.WriteString("^")
+	escaped := false
+	inClass := false
+	for _, char := range pattern {
+		switch {
+		case escaped:
+			result.WriteString(regexp.QuoteMeta(string(char)))
+			escaped = false
+		case char == '\\':
+			escaped = true
+		case inClass:
+			inClass = char != ']'
+			result.WriteRune(char)
+		case char == '[':
+			inClass = true
+			result.WriteRune(char)
+		case char == '*':
+			result.WriteString(".*")
+		case char == '?':
+			result.WriteString(".")
+		default:
+			result.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+	result.WriteString("$")
+	return regexp.Compile(result.String())
+}
+
+// ParseBranchTypeRule parses a rule in the "<branch type>=<pattern>" format used in the Git metadata.
+// Patterns enclosed in slashes are regular expressions, all other patterns are glob patterns.
+func ParseBranchTypeRule(text string) (BranchTypeRule, error) {
+	branchTypeName, pattern, found := strings.Cut(text, "=")
+	if !found {
+		return BranchTypeRule{}, fmt.Errorf(messages.BranchTypeRuleInvalidFormat, text) //nolint:exhaustruct
+	}
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		return NewBranchTypeRule(branchTypeName, pattern[1:len(pattern)-1], true)
+	}
+	return NewBranchTypeRule(branchTypeName, pattern, false)
+}
+
+// BranchTypeRules contains branch type rules in the order in which they apply.
+type BranchTypeRules []BranchTypeRule
+
+// Find provides the first rule that applies to the given branch, or nil if no rule applies.
+func (self BranchTypeRules) Find(branch gitdomain.LocalBranchName) *BranchTypeRule {
+	for _, rule := range self {
+		if rule.Matches(branch) {
+			return &rule
+		}
+	}
+	return nil
+}
+
+// String provides these rules in the format used in the Git metadata.
+func (self BranchTypeRules) String() string {
+	texts := make([]string, len(self))
+	for r, rule := range self {
+		texts[r] = rule.String()
+	}
+	return strings.Join(texts, " ")
+}
+
+// ParseBranchTypeRules parses the whitespace-separated rules in the given Git metadata value.
+func ParseBranchTypeRules(text string) (BranchTypeRules, error) {
+	fields := strings.Fields(text)
+	result := make(BranchTypeRules, len(fields))
+	for f, field := range fields {
+		rule, err := ParseBranchTypeRule(field)
+		if err != nil {
+			return result, err
+		}
+		result[f] = rule
+	}
+	return result, nil
+}
+
+func ParseBranchTypeRulesRef(text string) (*BranchTypeRules, error) {
+	result, err := ParseBranchTypeRules(text)
+	return &result, err
+}
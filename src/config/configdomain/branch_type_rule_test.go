@@ -0,0 +1,152 @@
+package configdomain_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchTypeRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewBranchTypeRule", func(t *testing.T) {
+		t.Parallel()
+		t.Run("glob pattern", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.NewBranchTypeRule("observed", "dependabot/*", false)
+			must.NoError(t, err)
+			want := configdomain.BranchTypeRule{BranchType: configdomain.BranchTypeObservedBranch, Glob: true, Pattern: "dependabot/*", Regex: regexp.MustCompile("^dependabot/.*$")}
+			must.Eq(t, want, have)
+		})
+		t.Run("regex", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.NewBranchTypeRule("perennial", "^release-\\d+$", true)
+			must.NoError(t, err)
+			want := configdomain.BranchTypeRule{BranchType: configdomain.BranchTypePerennialBranch, Glob: false, Pattern: "^release-\\d+$", Regex: regexp.MustCompile("^release-\\d+$")}
+			must.Eq(t, want, have)
+		})
+		t.Run("invalid glob", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewBranchTypeRule("observed", "dependabot/[", false)
+			must.Error(t, err)
+		})
+		t.Run("invalid regex", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewBranchTypeRule("observed", "dependabot/(", true)
+			must.Error(t, err)
+		})
+		t.Run("empty pattern", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewBranchTypeRule("observed", "", false)
+			must.Error(t, err)
+		})
+		t.Run("unknown branch type", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewBranchTypeRule("zonk", "dependabot/*", false)
+			must.Error(t, err)
+		})
+		t.Run("main branch", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewBranchTypeRule("main", "main", false)
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		t.Parallel()
+		t.Run("glob pattern", func(t *testing.T) {
+			t.Parallel()
+			rule, err := configdomain.NewBranchTypeRule("observed", "dependabot/*", false)
+			must.NoError(t, err)
+			tests := map[string]bool{
+				"dependabot/one":     true,
+				"dependabot/one/two": true,
+				"dependabot/":        true,
+				"dependabot":         false,
+				"my/dependabot/one":  false,
+				"feature":            false,
+			}
+			for give, want := range tests {
+				have := rule.Matches(gitdomain.NewLocalBranchName(give))
+				must.EqOp(t, want, have)
+			}
+		})
+		t.Run("glob pattern with wildcards and character classes", func(t *testing.T) {
+			t.Parallel()
+			rule, err := configdomain.NewBranchTypeRule("perennial", "release-?.[0-9]", false)
+			must.NoError(t, err)
+			tests := map[string]bool{
+				"release-1.2":  true,
+				"release-1.x":  false,
+				"release-1x2":  false,
+				"release-/.2":  true,
+				"release-1.23": false,
+			}
+			for give, want := range tests {
+				have := rule.Matches(gitdomain.NewLocalBranchName(give))
+				must.EqOp(t, want, have)
+			}
+		})
+		t.Run("regex", func(t *testing.T) {
+			t.Parallel()
+			rule, err := configdomain.NewBranchTypeRule("perennial", "^release/", true)
+			must.NoError(t, err)
+			tests := map[string]bool{
+				"release/1":     true,
+				"release/1/fix": true,
+				"my/release/1":  false,
+				"feature":       false,
+			}
+			for give, want := range tests {
+				have := rule.Matches(gitdomain.NewLocalBranchName(give))
+				must.EqOp(t, want, have)
+			}
+		})
+	})
+
+	t.Run("ParseBranchTypeRules", func(t *testing.T) {
+		t.Parallel()
+		t.Run("multiple rules", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchTypeRules("observed=dependabot/*  perennial=/^release-\\d+$/")
+			must.NoError(t, err)
+			want := configdomain.BranchTypeRules{
+				{BranchType: configdomain.BranchTypeObservedBranch, Glob: true, Pattern: "dependabot/*", Regex: regexp.MustCompile("^dependabot/.*$")},
+				{BranchType: configdomain.BranchTypePerennialBranch, Glob: false, Pattern: "^release-\\d+$", Regex: regexp.MustCompile("^release-\\d+$")},
+			}
+			must.Eq(t, want, have)
+			must.EqOp(t, "observed=dependabot/* perennial=/^release-\\d+$/", have.String())
+		})
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseBranchTypeRules("")
+			must.NoError(t, err)
+			must.Len(t, 0, have)
+		})
+		t.Run("missing branch type", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseBranchTypeRules("dependabot/*")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("BranchTypeRules.Find", func(t *testing.T) {
+		t.Parallel()
+		rules, err := configdomain.ParseBranchTypeRules("observed=release/legacy perennial=release/*")
+		must.NoError(t, err)
+		t.Run("first matching rule wins", func(t *testing.T) {
+			t.Parallel()
+			have := rules.Find(gitdomain.NewLocalBranchName("release/legacy"))
+			must.NotNil(t, have)
+			must.EqOp(t, configdomain.BranchTypeObservedBranch, have.BranchType)
+		})
+		t.Run("no matching rule", func(t *testing.T) {
+			t.Parallel()
+			have := rules.Find(gitdomain.NewLocalBranchName("feature"))
+			must.Nil(t, have)
+		})
+	})
+}
@@ -0,0 +1,19 @@
+package configdomain
+
+// BranchTypeSource describes which setting determines the type of a branch.
+type BranchTypeSource int
+
+const (
+	BranchTypeSourceDefault    BranchTypeSource = iota // no setting applies to the branch
+	BranchTypeSourceMainBranch                         // the branch is the main branch
+	BranchTypeSourceBranchList                         // the branch is listed in the branches setting of its type
+	BranchTypeSourceRule                               // the branch matches a branch type rule
+	BranchTypeSourceRegex                              // the branch matches the regex setting of its type
+)
+
+// BranchTypeMatch describes the type of a branch and the setting that determines it.
+type BranchTypeMatch struct {
+	BranchType BranchType
+	Rule       *BranchTypeRule // the rule that matched if Source is BranchTypeSourceRule
+	Source     BranchTypeSource
+}
@@ -0,0 +1,54 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// ContributionRegex contains the "branches.contribution-regex" setting.
+// The regular expression gets compiled once when creating a ContributionRegex.
+// Invalid regular expressions match no branches.
+type ContributionRegex struct {
+	regex *regexp.Regexp // the compiled text, nil if text is empty or not a valid regular expression
+	text  string
+}
+
+// IsEmpty indicates whether this ContributionRegex is not set.
+func (self ContributionRegex) IsEmpty() bool {
+	return self.text == ""
+}
+
+// MatchesBranch indicates whether the given branch matches this ContributionRegex.
+func (self ContributionRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return self.regex != nil && self.regex.MatchString(branch.String())
+}
+
+// Problem describes why this ContributionRegex is not a valid regular expression, or provides nil if it is valid.
+func (self ContributionRegex) Problem() error {
+	if self.regex != nil || self.text == "" {
+		return nil
+	}
+	_, err := regexp.Compile(self.text)
+	return fmt.Errorf(messages.BranchTypeRegexInvalid, "contribution", self.text, err)
+}
+
+func (self ContributionRegex) String() string {
+	return self.text
+}
+
+// NewContributionRegex provides a ContributionRegex with the given text.
+func NewContributionRegex(value string) ContributionRegex {
+	regex, err := regexp.Compile(value)
+	if err != nil || value == "" {
+		regex = nil
+	}
+	return ContributionRegex{regex: regex, text: value}
+}
+
+func NewContributionRegexRef(value string) *ContributionRegex {
+	result := NewContributionRegex(value)
+	return &result
+}
@@ -8,44 +8,46 @@ import (
 // FullConfig is the merged configuration to be used by Git Town commands.
 type FullConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         AzureDevOpsToken
+	BitbucketAppPassword     BitbucketAppPassword
+	BitbucketUsername        BitbucketUsername
+	BranchTypeRules          BranchTypeRules
 	ContributionBranches     gitdomain.LocalBranchNames
+	ContributionRegex        ContributionRegex
 	GitHubToken              GitHubToken
 	GitLabToken              GitLabToken
 	GitUserEmail             string
 	GitUserName              string
 	GiteaToken               GiteaToken
+	HostingConnectorCommand  HostingConnectorCommand
 	HostingOriginHostname    HostingOriginHostname
 	HostingPlatform          HostingPlatform
 	Lineage                  Lineage
 	MainBranch               gitdomain.LocalBranchName
 	ObservedBranches         gitdomain.LocalBranchNames
+	ObservedRegex            ObservedRegex
 	Offline                  Offline
 	ParkedBranches           gitdomain.LocalBranchNames
 	PerennialBranches        gitdomain.LocalBranchNames
 	PerennialRegex           PerennialRegex
+	PreviousParentSHAs       PreviousParentSHAs
+	ProposalStackTemplate    ProposalStackTemplate
+	PrototypeBranches        gitdomain.LocalBranchNames
 	PushHook                 PushHook
 	PushNewBranches          PushNewBranches
+	ShareLineage             ShareLineage
 	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
 	SyncBeforeShip           SyncBeforeShip
 	SyncFeatureStrategy      SyncFeatureStrategy
 	SyncPerennialStrategy    SyncPerennialStrategy
+	SyncProposalStacks       SyncProposalStacks
+	SyncStrategyOverrides    SyncStrategyOverrides
+	SyncStrategyRules        SyncStrategyRules
 	SyncUpstream             SyncUpstream
 }
 
 func (self *FullConfig) BranchType(branch gitdomain.LocalBranchName) BranchType {
-	switch {
-	case self.IsMainBranch(branch):
-		return BranchTypeMainBranch
-	case self.IsPerennialBranch(branch):
-		return BranchTypePerennialBranch
-	case self.IsContributionBranch(branch):
-		return BranchTypeContributionBranch
-	case self.IsObservedBranch(branch):
-		return BranchTypeObservedBranch
-	case self.IsParkedBranch(branch):
-		return BranchTypeParkedBranch
-	}
-	return BranchTypeFeatureBranch
+	return self.MatchBranchType(branch).BranchType
 }
 
 // ContainsLineage indicates whether this configuration contains any lineage entries.
@@ -54,7 +56,7 @@ func (self *FullConfig) ContainsLineage() bool {
 }
 
 func (self *FullConfig) IsContributionBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ContributionBranches, branch)
+	return self.BranchType(branch) == BranchTypeContributionBranch
 }
 
 // IsMainBranch indicates whether the branch with the given name
@@ -70,7 +72,7 @@ func (self *FullConfig) IsMainOrPerennialBranch(branch gitdomain.LocalBranchName
 }
 
 func (self *FullConfig) IsObservedBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ObservedBranches, branch)
+	return self.BranchType(branch) == BranchTypeObservedBranch
 }
 
 func (self *FullConfig) IsOnline() bool {
@@ -78,20 +80,56 @@ func (self *FullConfig) IsOnline() bool {
 }
 
 func (self *FullConfig) IsParkedBranch(branch gitdomain.LocalBranchName) bool {
-	return slice.Contains(self.ParkedBranches, branch)
+	return self.BranchType(branch) == BranchTypeParkedBranch
 }
 
 func (self *FullConfig) IsPerennialBranch(branch gitdomain.LocalBranchName) bool {
-	if slice.Contains(self.PerennialBranches, branch) {
-		return true
-	}
-	return self.PerennialRegex.MatchesBranch(branch)
+	return self.BranchType(branch) == BranchTypePerennialBranch
+}
+
+func (self *FullConfig) IsPrototypeBranch(branch gitdomain.LocalBranchName) bool {
+	return self.BranchType(branch) == BranchTypePrototypeBranch
 }
 
 func (self *FullConfig) MainAndPerennials() gitdomain.LocalBranchNames {
 	return append(gitdomain.LocalBranchNames{self.MainBranch}, self.PerennialBranches...)
 }
 
+// MatchBranchType determines the type of the given branch:
+// the main branch, otherwise a perennial branch if the perennial branches setting lists the branch or the perennial regex matches it,
+// otherwise the branch type whose branches setting lists the branch,
+// otherwise the first matching branch type rule,
+// otherwise the branch type whose regex setting matches the branch,
+// otherwise a feature branch.
+func (self *FullConfig) MatchBranchType(branch gitdomain.LocalBranchName) BranchTypeMatch {
+	switch {
+	case self.IsMainBranch(branch):
+		return BranchTypeMatch{BranchType: BranchTypeMainBranch, Rule: nil, Source: BranchTypeSourceMainBranch}
+	case slice.Contains(self.PerennialBranches, branch):
+		return BranchTypeMatch{BranchType: BranchTypePerennialBranch, Rule: nil, Source: BranchTypeSourceBranchList}
+	case self.PerennialRegex.MatchesBranch(branch):
+		return BranchTypeMatch{BranchType: BranchTypePerennialBranch, Rule: nil, Source: BranchTypeSourceRegex}
+	case slice.Contains(self.ContributionBranches, branch):
+		return BranchTypeMatch{BranchType: BranchTypeContributionBranch, Rule: nil, Source: BranchTypeSourceBranchList}
+	case slice.Contains(self.ObservedBranches, branch):
+		return BranchTypeMatch{BranchType: BranchTypeObservedBranch, Rule: nil, Source: BranchTypeSourceBranchList}
+	case slice.Contains(self.ParkedBranches, branch):
+		return BranchTypeMatch{BranchType: BranchTypeParkedBranch, Rule: nil, Source: BranchTypeSourceBranchList}
+	case slice.Contains(self.PrototypeBranches, branch):
+		return BranchTypeMatch{BranchType: BranchTypePrototypeBranch, Rule: nil, Source: BranchTypeSourceBranchList}
+	}
+	if rule := self.BranchTypeRules.Find(branch); rule != nil {
+		return BranchTypeMatch{BranchType: rule.BranchType, Rule: rule, Source: BranchTypeSourceRule}
+	}
+	switch {
+	case self.ContributionRegex.MatchesBranch(branch):
+		return BranchTypeMatch{BranchType: BranchTypeContributionBranch, Rule: nil, Source: BranchTypeSourceRegex}
+	case self.ObservedRegex.MatchesBranch(branch):
+		return BranchTypeMatch{BranchType: BranchTypeObservedBranch, Rule: nil, Source: BranchTypeSourceRegex}
+	}
+	return BranchTypeMatch{BranchType: BranchTypeFeatureBranch, Rule: nil, Source: BranchTypeSourceDefault}
+}
+
 // Merges the given PartialConfig into this configuration object.
 func (self *FullConfig) Merge(other PartialConfig) {
 	for key, value := range other.Aliases {
@@ -102,9 +140,27 @@ func (self *FullConfig) Merge(other PartialConfig) {
 			self.Lineage[child] = parent
 		}
 	}
+	if other.AzureDevOpsToken != nil {
+		self.AzureDevOpsToken = *other.AzureDevOpsToken
+	}
+	if other.BitbucketAppPassword != nil {
+		self.BitbucketAppPassword = *other.BitbucketAppPassword
+	}
+	if other.BitbucketUsername != nil {
+		self.BitbucketUsername = *other.BitbucketUsername
+	}
+	if other.BranchTypeRules != nil {
+		self.BranchTypeRules = *other.BranchTypeRules
+	}
 	if other.ContributionBranches != nil {
 		self.ContributionBranches = append(self.ContributionBranches, *other.ContributionBranches...)
 	}
+	if other.ContributionRegex != nil {
+		self.ContributionRegex = *other.ContributionRegex
+	}
+	if other.HostingConnectorCommand != nil {
+		self.HostingConnectorCommand = *other.HostingConnectorCommand
+	}
 	if other.HostingOriginHostname != nil {
 		self.HostingOriginHostname = *other.HostingOriginHostname
 	}
@@ -135,6 +191,9 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.ObservedBranches != nil {
 		self.ObservedBranches = append(self.ObservedBranches, *other.ObservedBranches...)
 	}
+	if other.ObservedRegex != nil {
+		self.ObservedRegex = *other.ObservedRegex
+	}
 	if other.Offline != nil {
 		self.Offline = *other.Offline
 	}
@@ -147,9 +206,23 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.PerennialRegex != nil {
 		self.PerennialRegex = *other.PerennialRegex
 	}
+	if other.PreviousParentSHAs != nil {
+		for branch, sha := range *other.PreviousParentSHAs {
+			self.PreviousParentSHAs[branch] = sha
+		}
+	}
+	if other.ProposalStackTemplate != nil {
+		self.ProposalStackTemplate = *other.ProposalStackTemplate
+	}
+	if other.PrototypeBranches != nil {
+		self.PrototypeBranches = append(self.PrototypeBranches, *other.PrototypeBranches...)
+	}
 	if other.PushHook != nil {
 		self.PushHook = *other.PushHook
 	}
+	if other.ShareLineage != nil {
+		self.ShareLineage = *other.ShareLineage
+	}
 	if other.ShipDeleteTrackingBranch != nil {
 		self.ShipDeleteTrackingBranch = *other.ShipDeleteTrackingBranch
 	}
@@ -162,11 +235,36 @@ func (self *FullConfig) Merge(other PartialConfig) {
 	if other.SyncPerennialStrategy != nil {
 		self.SyncPerennialStrategy = *other.SyncPerennialStrategy
 	}
+	if other.SyncProposalStacks != nil {
+		self.SyncProposalStacks = *other.SyncProposalStacks
+	}
+	if other.SyncStrategyOverrides != nil {
+		for branch, strategy := range *other.SyncStrategyOverrides {
+			self.SyncStrategyOverrides[branch] = strategy
+		}
+	}
+	if other.SyncStrategyRules != nil {
+		self.SyncStrategyRules = *other.SyncStrategyRules
+	}
 	if other.SyncUpstream != nil {
 		self.SyncUpstream = *other.SyncUpstream
 	}
 }
 
+// SyncFeatureStrategyFor provides the sync-feature strategy to use for the given branch:
+// the strategy configured for this particular branch in the Git metadata,
+// otherwise the first matching rule in the config file,
+// otherwise the sync-feature strategy of the repository.
+func (self *FullConfig) SyncFeatureStrategyFor(branch gitdomain.LocalBranchName) SyncFeatureStrategy {
+	if strategy, has := self.SyncStrategyOverrides[branch]; has {
+		return strategy
+	}
+	if rule := self.SyncStrategyRules.Find(branch); rule != nil {
+		return rule.Strategy
+	}
+	return self.SyncFeatureStrategy
+}
+
 func (self *FullConfig) NoPushHook() NoPushHook {
 	return self.PushHook.Negate()
 }
@@ -175,6 +273,11 @@ func (self *FullConfig) Online() Online {
 	return self.Offline.ToOnline()
 }
 
+// ShouldPushNewBranch indicates whether Git Town should push the given newly created branch to origin.
+func (self *FullConfig) ShouldPushNewBranch(branch gitdomain.LocalBranchName) bool {
+	return self.ShouldPushNewBranches() && self.BranchType(branch).ShouldPush(branch, branch)
+}
+
 func (self *FullConfig) ShouldPushNewBranches() bool {
 	return self.PushNewBranches.Bool()
 }
@@ -183,27 +286,41 @@ func (self *FullConfig) ShouldPushNewBranches() bool {
 func DefaultConfig() FullConfig {
 	return FullConfig{
 		Aliases:                  Aliases{},
+		AzureDevOpsToken:         "",
+		BitbucketAppPassword:     "",
+		BitbucketUsername:        "",
+		BranchTypeRules:          BranchTypeRules{},
 		ContributionBranches:     gitdomain.NewLocalBranchNames(),
+		ContributionRegex:        NewContributionRegex(""),
 		GitHubToken:              "",
 		GitLabToken:              "",
 		GitUserEmail:             "",
 		GitUserName:              "",
 		GiteaToken:               "",
+		HostingConnectorCommand:  "",
 		HostingOriginHostname:    "",
 		HostingPlatform:          HostingPlatformNone,
 		Lineage:                  Lineage{},
 		MainBranch:               gitdomain.EmptyLocalBranchName(),
 		ObservedBranches:         gitdomain.NewLocalBranchNames(),
+		ObservedRegex:            NewObservedRegex(""),
 		Offline:                  false,
 		ParkedBranches:           gitdomain.NewLocalBranchNames(),
 		PerennialBranches:        gitdomain.NewLocalBranchNames(),
-		PerennialRegex:           "",
+		PerennialRegex:           NewPerennialRegex(""),
+		PreviousParentSHAs:       PreviousParentSHAs{},
+		ProposalStackTemplate:    "",
+		PrototypeBranches:        gitdomain.NewLocalBranchNames(),
 		PushHook:                 true,
 		PushNewBranches:          false,
+		ShareLineage:             false,
 		ShipDeleteTrackingBranch: true,
 		SyncBeforeShip:           false,
 		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
 		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
+		SyncProposalStacks:       false,
+		SyncStrategyOverrides:    SyncStrategyOverrides{},
+		SyncStrategyRules:        SyncStrategyRules{},
 		SyncUpstream:             true,
 	}
 }
@@ -12,6 +12,26 @@ import (
 func TestFullConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("IsContributionBranch", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			ContributionBranches: gitdomain.NewLocalBranchNames("contribution"),
+			ContributionRegex:    configdomain.NewContributionRegex("^coworker/"),
+			MainBranch:           gitdomain.NewLocalBranchName("main"),
+		}
+		tests := map[string]bool{
+			"contribution":     true,
+			"coworker/feature": true,
+			"feature":          false,
+			"main":             false,
+			"my/coworker/foo":  false,
+		}
+		for give, want := range tests {
+			have := config.IsContributionBranch(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("IsMainOrPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.FullConfig{ //nolint:exhaustruct
@@ -49,12 +69,31 @@ func TestFullConfig(t *testing.T) {
 		must.False(t, config.IsMainBranch(gitdomain.NewLocalBranchName("peren2")))
 	})
 
+	t.Run("IsObservedBranch", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			MainBranch:       gitdomain.NewLocalBranchName("main"),
+			ObservedBranches: gitdomain.NewLocalBranchNames("observed"),
+			ObservedRegex:    configdomain.NewObservedRegex("^dependabot/"),
+		}
+		tests := map[string]bool{
+			"dependabot/go-1.22": true,
+			"feature":            false,
+			"main":               false,
+			"observed":           true,
+		}
+		for give, want := range tests {
+			have := config.IsObservedBranch(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("IsPerennialBranch", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.FullConfig{ //nolint:exhaustruct
 			MainBranch:        gitdomain.NewLocalBranchName("main"),
 			PerennialBranches: gitdomain.NewLocalBranchNames("peren1", "peren2"),
-			PerennialRegex:    "release-.*",
+			PerennialRegex:    configdomain.NewPerennialRegex("release-.*"),
 		}
 		tests := map[string]bool{
 			"main":      false,
@@ -72,6 +111,27 @@ func TestFullConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("IsPrototypeBranch", func(t *testing.T) {
+		t.Parallel()
+		prototypeRule, err := configdomain.NewBranchTypeRule("prototype", "spike/*", false)
+		must.NoError(t, err)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			BranchTypeRules:   configdomain.BranchTypeRules{prototypeRule},
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PrototypeBranches: gitdomain.NewLocalBranchNames("prototype"),
+		}
+		tests := map[string]bool{
+			"feature":     false,
+			"main":        false,
+			"prototype":   true,
+			"spike/cache": true,
+		}
+		for give, want := range tests {
+			have := config.IsPrototypeBranch(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
 	t.Run("MainAndPerennials", func(t *testing.T) {
 		t.Parallel()
 		config := configdomain.FullConfig{ //nolint:exhaustruct
@@ -82,4 +142,86 @@ func TestFullConfig(t *testing.T) {
 		want := gitdomain.NewLocalBranchNames("main", "perennial-1", "perennial-2")
 		must.Eq(t, want, have)
 	})
+
+	t.Run("MatchBranchType", func(t *testing.T) {
+		t.Parallel()
+		dependabotRule, err := configdomain.NewBranchTypeRule("observed", "dependabot/*", false)
+		must.NoError(t, err)
+		releaseRule, err := configdomain.NewBranchTypeRule("perennial", "^release/", true)
+		must.NoError(t, err)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			BranchTypeRules:   configdomain.BranchTypeRules{dependabotRule, releaseRule},
+			ContributionRegex: configdomain.NewContributionRegex("^coworker/"),
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			ObservedBranches:  gitdomain.NewLocalBranchNames("legacy/observed"),
+			ParkedBranches:    gitdomain.NewLocalBranchNames("dependabot/parked"),
+			PerennialBranches: gitdomain.NewLocalBranchNames("coworker/perennial"),
+			PerennialRegex:    configdomain.NewPerennialRegex("^legacy/"),
+			PrototypeBranches: gitdomain.NewLocalBranchNames("prototype"),
+		}
+		tests := map[string]configdomain.BranchTypeMatch{
+			"main":               {BranchType: configdomain.BranchTypeMainBranch, Rule: nil, Source: configdomain.BranchTypeSourceMainBranch},
+			"coworker/perennial": {BranchType: configdomain.BranchTypePerennialBranch, Rule: nil, Source: configdomain.BranchTypeSourceBranchList},
+			"legacy/1":           {BranchType: configdomain.BranchTypePerennialBranch, Rule: nil, Source: configdomain.BranchTypeSourceRegex},
+			"legacy/observed":    {BranchType: configdomain.BranchTypePerennialBranch, Rule: nil, Source: configdomain.BranchTypeSourceRegex},
+			"dependabot/parked":  {BranchType: configdomain.BranchTypeParkedBranch, Rule: nil, Source: configdomain.BranchTypeSourceBranchList},
+			"prototype":          {BranchType: configdomain.BranchTypePrototypeBranch, Rule: nil, Source: configdomain.BranchTypeSourceBranchList},
+			"dependabot/go":      {BranchType: configdomain.BranchTypeObservedBranch, Rule: &dependabotRule, Source: configdomain.BranchTypeSourceRule},
+			"release/2":          {BranchType: configdomain.BranchTypePerennialBranch, Rule: &releaseRule, Source: configdomain.BranchTypeSourceRule},
+			"coworker/feature":   {BranchType: configdomain.BranchTypeContributionBranch, Rule: nil, Source: configdomain.BranchTypeSourceRegex},
+			"feature":            {BranchType: configdomain.BranchTypeFeatureBranch, Rule: nil, Source: configdomain.BranchTypeSourceDefault},
+		}
+		for give, want := range tests {
+			have := config.MatchBranchType(gitdomain.NewLocalBranchName(give))
+			must.Eq(t, want, have)
+		}
+	})
+
+	t.Run("ShouldPushNewBranch", func(t *testing.T) {
+		t.Parallel()
+		prototypeRule, err := configdomain.NewBranchTypeRule("prototype", "spike/*", false)
+		must.NoError(t, err)
+		t.Run("push-new-branches enabled", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.FullConfig{ //nolint:exhaustruct
+				BranchTypeRules: configdomain.BranchTypeRules{prototypeRule},
+				MainBranch:      gitdomain.NewLocalBranchName("main"),
+				PushNewBranches: true,
+			}
+			must.True(t, config.ShouldPushNewBranch(gitdomain.NewLocalBranchName("feature")))
+			must.False(t, config.ShouldPushNewBranch(gitdomain.NewLocalBranchName("spike/cache")))
+		})
+		t.Run("push-new-branches disabled", func(t *testing.T) {
+			t.Parallel()
+			config := configdomain.FullConfig{ //nolint:exhaustruct
+				MainBranch:      gitdomain.NewLocalBranchName("main"),
+				PushNewBranches: false,
+			}
+			must.False(t, config.ShouldPushNewBranch(gitdomain.NewLocalBranchName("feature")))
+		})
+	})
+
+	t.Run("SyncFeatureStrategyFor", func(t *testing.T) {
+		t.Parallel()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			SyncFeatureStrategy: configdomain.SyncFeatureStrategyMerge,
+			SyncStrategyOverrides: configdomain.SyncStrategyOverrides{
+				gitdomain.NewLocalBranchName("shared/override"): configdomain.SyncFeatureStrategyCompress,
+			},
+			SyncStrategyRules: configdomain.SyncStrategyRules{
+				{Branches: "shared/*", Strategy: configdomain.SyncFeatureStrategyRebase},
+				{Branches: "shared/*/*", Strategy: configdomain.SyncFeatureStrategyCompress},
+			},
+		}
+		tests := map[string]configdomain.SyncFeatureStrategy{
+			"feature":         configdomain.SyncFeatureStrategyMerge,
+			"shared/one":      configdomain.SyncFeatureStrategyRebase,
+			"shared/one/two":  configdomain.SyncFeatureStrategyCompress,
+			"shared/override": configdomain.SyncFeatureStrategyCompress,
+		}
+		for give, want := range tests {
+			have := config.SyncFeatureStrategyFor(gitdomain.NewLocalBranchName(give))
+			must.EqOp(t, want, have)
+		}
+	})
 }
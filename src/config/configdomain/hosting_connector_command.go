@@ -0,0 +1,22 @@
+package configdomain
+
+import "github.com/kballard/go-shellquote"
+
+// HostingConnectorCommand is the executable that Git Town talks to
+// instead of the built-in hosting connectors.
+type HostingConnectorCommand string
+
+// Argv provides the executable and arguments of this command.
+// The command uses shell quoting, so that paths containing spaces can be quoted.
+func (self HostingConnectorCommand) Argv() ([]string, error) {
+	return shellquote.Split(string(self))
+}
+
+func (self HostingConnectorCommand) String() string {
+	return string(self)
+}
+
+func NewHostingConnectorCommandRef(value string) *HostingConnectorCommand {
+	command := HostingConnectorCommand(value)
+	return &command
+}
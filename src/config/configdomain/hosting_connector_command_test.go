@@ -0,0 +1,33 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestHostingConnectorCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Argv", func(t *testing.T) {
+		t.Parallel()
+		t.Run("executable and arguments", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.HostingConnectorCommand("review-tool connect --verbose").Argv()
+			must.NoError(t, err)
+			must.Eq(t, []string{"review-tool", "connect", "--verbose"}, have)
+		})
+		t.Run("quoted executable path with spaces", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.HostingConnectorCommand(`"/c/Program Files/review-tool" 'an argument'`).Argv()
+			must.NoError(t, err)
+			must.Eq(t, []string{"/c/Program Files/review-tool", "an argument"}, have)
+		})
+		t.Run("unterminated quote", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.HostingConnectorCommand(`"/c/Program Files/review-tool`).Argv()
+			must.Error(t, err)
+		})
+	})
+}
@@ -13,11 +13,12 @@ type HostingPlatform string
 func (self HostingPlatform) String() string { return string(self) }
 
 const (
-	HostingPlatformBitbucket = HostingPlatform("bitbucket")
-	HostingPlatformGitHub    = HostingPlatform("github")
-	HostingPlatformGitLab    = HostingPlatform("gitlab")
-	HostingPlatformGitea     = HostingPlatform("gitea")
-	HostingPlatformNone      = HostingPlatform("") // no hosting or auto-detect
+	HostingPlatformAzureDevOps = HostingPlatform("azure-devops")
+	HostingPlatformBitbucket   = HostingPlatform("bitbucket")
+	HostingPlatformGitHub      = HostingPlatform("github")
+	HostingPlatformGitLab      = HostingPlatform("gitlab")
+	HostingPlatformGitea       = HostingPlatform("gitea")
+	HostingPlatformNone        = HostingPlatform("") // no hosting or auto-detect
 )
 
 // NewHostingPlatform provides the HostingPlatform enum matching the given text.
@@ -41,6 +42,7 @@ func NewHostingPlatformRef(platformName string) (*HostingPlatform, error) {
 func hostingPlatforms() []HostingPlatform {
 	return []HostingPlatform{
 		HostingPlatformNone,
+		HostingPlatformAzureDevOps,
 		HostingPlatformBitbucket,
 		HostingPlatformGitHub,
 		HostingPlatformGitLab,
@@ -33,6 +33,16 @@ func (self Lineage) BranchAndAncestors(branchName gitdomain.LocalBranchName) git
 	return append(self.Ancestors(branchName), branchName)
 }
 
+// BranchAndDescendants provides the given branch followed by all its descendants,
+// ordered depth-first with siblings sorted alphabetically.
+func (self Lineage) BranchAndDescendants(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{branch}
+	for _, child := range self.Children(branch) {
+		result = append(result, self.BranchAndDescendants(child)...)
+	}
+	return result
+}
+
 // BranchNames provides the names of all branches in this Lineage, sorted alphabetically.
 func (self Lineage) BranchNames() gitdomain.LocalBranchNames {
 	result := gitdomain.LocalBranchNames(maps.Keys(self))
@@ -100,6 +100,17 @@ func TestLineage(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("BranchAndDescendants", func(t *testing.T) {
+		t.Parallel()
+		lineage := configdomain.Lineage{}
+		lineage[one] = main
+		lineage[two] = one
+		lineage[three] = main
+		have := lineage.BranchAndDescendants(main)
+		want := gitdomain.LocalBranchNames{main, one, two, three}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("BranchNames", func(t *testing.T) {
 		t.Parallel()
 		lineage := configdomain.Lineage{}
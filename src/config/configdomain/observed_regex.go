@@ -0,0 +1,54 @@
+package configdomain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// ObservedRegex contains the "branches.observed-regex" setting.
+// The regular expression gets compiled once when creating a ObservedRegex.
+// Invalid regular expressions match no branches.
+type ObservedRegex struct {
+	regex *regexp.Regexp // the compiled text, nil if text is empty or not a valid regular expression
+	text  string
+}
+
+// IsEmpty indicates whether this ObservedRegex is not set.
+func (self ObservedRegex) IsEmpty() bool {
+	return self.text == ""
+}
+
+// MatchesBranch indicates whether the given branch matches this ObservedRegex.
+func (self ObservedRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
+	return self.regex != nil && self.regex.MatchString(branch.String())
+}
+
+// Problem describes why this ObservedRegex is not a valid regular expression, or provides nil if it is valid.
+func (self ObservedRegex) Problem() error {
+	if self.regex != nil || self.text == "" {
+		return nil
+	}
+	_, err := regexp.Compile(self.text)
+	return fmt.Errorf(messages.BranchTypeRegexInvalid, "observed", self.text, err)
+}
+
+func (self ObservedRegex) String() string {
+	return self.text
+}
+
+// NewObservedRegex provides a ObservedRegex with the given text.
+func NewObservedRegex(value string) ObservedRegex {
+	regex, err := regexp.Compile(value)
+	if err != nil || value == "" {
+		regex = nil
+	}
+	return ObservedRegex{regex: regex, text: value}
+}
+
+func NewObservedRegexRef(value string) *ObservedRegex {
+	result := NewObservedRegex(value)
+	return &result
+}
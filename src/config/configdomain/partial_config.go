@@ -7,27 +7,41 @@ import (
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
 	Aliases                  Aliases
+	AzureDevOpsToken         *AzureDevOpsToken
+	BitbucketAppPassword     *BitbucketAppPassword
+	BitbucketUsername        *BitbucketUsername
+	BranchTypeRules          *BranchTypeRules
 	ContributionBranches     *gitdomain.LocalBranchNames
+	ContributionRegex        *ContributionRegex
 	GitHubToken              *GitHubToken
 	GitLabToken              *GitLabToken
 	GitUserEmail             *string
 	GitUserName              *string
 	GiteaToken               *GiteaToken
+	HostingConnectorCommand  *HostingConnectorCommand
 	HostingOriginHostname    *HostingOriginHostname
 	HostingPlatform          *HostingPlatform
 	Lineage                  *Lineage
 	MainBranch               *gitdomain.LocalBranchName
 	ObservedBranches         *gitdomain.LocalBranchNames
+	ObservedRegex            *ObservedRegex
 	Offline                  *Offline
 	ParkedBranches           *gitdomain.LocalBranchNames
 	PerennialBranches        *gitdomain.LocalBranchNames
 	PerennialRegex           *PerennialRegex
+	PreviousParentSHAs       *PreviousParentSHAs
+	ProposalStackTemplate    *ProposalStackTemplate
+	PrototypeBranches        *gitdomain.LocalBranchNames
 	PushHook                 *PushHook
 	PushNewBranches          *PushNewBranches
+	ShareLineage             *ShareLineage
 	ShipDeleteTrackingBranch *ShipDeleteTrackingBranch
 	SyncBeforeShip           *SyncBeforeShip
 	SyncFeatureStrategy      *SyncFeatureStrategy
 	SyncPerennialStrategy    *SyncPerennialStrategy
+	SyncProposalStacks       *SyncProposalStacks
+	SyncStrategyOverrides    *SyncStrategyOverrides
+	SyncStrategyRules        *SyncStrategyRules
 	SyncUpstream             *SyncUpstream
 }
 
@@ -4,31 +4,51 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/git-town/git-town/v13/src/cli/dialog/components"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
 )
 
 // PerennialRegex contains the "branches.perennial-regex" setting.
-type PerennialRegex string
+// The regular expression gets compiled once when creating a PerennialRegex.
+// Invalid regular expressions match no branches.
+type PerennialRegex struct {
+	regex *regexp.Regexp // the compiled text, nil if text is empty or not a valid regular expression
+	text  string
+}
+
+// IsEmpty indicates whether this PerennialRegex is not set.
+func (self PerennialRegex) IsEmpty() bool {
+	return self.text == ""
+}
 
 // MatchesBranch indicates whether the given branch matches this PerennialRegex.
 func (self PerennialRegex) MatchesBranch(branch gitdomain.LocalBranchName) bool {
-	if self == "" {
-		return false
-	}
-	re, err := regexp.Compile(string(self))
-	if err != nil {
-		fmt.Println(components.Red().Styled(fmt.Sprintf("Error in perennial regex %q: %s", self, err.Error())))
-		return false
+	return self.regex != nil && self.regex.MatchString(branch.String())
+}
+
+// Problem describes why this PerennialRegex is not a valid regular expression, or provides nil if it is valid.
+func (self PerennialRegex) Problem() error {
+	if self.regex != nil || self.text == "" {
+		return nil
 	}
-	return re.MatchString(branch.String())
+	_, err := regexp.Compile(self.text)
+	return fmt.Errorf(messages.BranchTypeRegexInvalid, "perennial", self.text, err)
 }
 
 func (self PerennialRegex) String() string {
-	return string(self)
+	return self.text
+}
+
+// NewPerennialRegex provides a PerennialRegex with the given text.
+func NewPerennialRegex(value string) PerennialRegex {
+	regex, err := regexp.Compile(value)
+	if err != nil || value == "" {
+		regex = nil
+	}
+	return PerennialRegex{regex: regex, text: value}
 }
 
 func NewPerennialRegexRef(value string) *PerennialRegex {
-	result := PerennialRegex(value)
+	result := NewPerennialRegex(value)
 	return &result
 }
@@ -13,14 +13,14 @@ func TestPerennialRegex(t *testing.T) {
 
 	t.Run("empty regex matches nothing", func(t *testing.T) {
 		t.Parallel()
-		perennialRegex := configdomain.PerennialRegex("")
+		perennialRegex := configdomain.NewPerennialRegex("")
 		must.False(t, perennialRegex.MatchesBranch(""))
 		must.False(t, perennialRegex.MatchesBranch("foo"))
 	})
 
 	t.Run("only characters, no wildcards matches all branch names that contain that phrase", func(t *testing.T) {
 		t.Parallel()
-		perennialRegex := configdomain.PerennialRegex("release")
+		perennialRegex := configdomain.NewPerennialRegex("release")
 		tests := map[string]bool{
 			"":                false,
 			"release":         true,
@@ -36,7 +36,7 @@ func TestPerennialRegex(t *testing.T) {
 
 	t.Run("with wildcards", func(t *testing.T) {
 		t.Parallel()
-		perennialRegex := configdomain.PerennialRegex("release-.*")
+		perennialRegex := configdomain.NewPerennialRegex("release-.*")
 		tests := map[string]bool{
 			"":                false,
 			"release":         false,
@@ -51,4 +51,28 @@ func TestPerennialRegex(t *testing.T) {
 			must.Eq(t, want, have)
 		}
 	})
+
+	t.Run("invalid regex matches nothing", func(t *testing.T) {
+		t.Parallel()
+		perennialRegex := configdomain.NewPerennialRegex("release-(")
+		must.False(t, perennialRegex.MatchesBranch("release-("))
+		must.False(t, perennialRegex.MatchesBranch("release-1"))
+		must.EqOp(t, "release-(", perennialRegex.String())
+	})
+
+	t.Run("Problem", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid regex", func(t *testing.T) {
+			t.Parallel()
+			must.NoError(t, configdomain.NewPerennialRegex("^release-").Problem())
+		})
+		t.Run("empty regex", func(t *testing.T) {
+			t.Parallel()
+			must.NoError(t, configdomain.NewPerennialRegex("").Problem())
+		})
+		t.Run("invalid regex", func(t *testing.T) {
+			t.Parallel()
+			must.Error(t, configdomain.NewPerennialRegex("^release-(").Problem())
+		})
+	})
 }
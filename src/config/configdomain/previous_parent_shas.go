@@ -0,0 +1,8 @@
+package configdomain
+
+import "github.com/git-town/git-town/v13/src/git/gitdomain"
+
+// PreviousParentSHAs contains the last SHAs of the shipped or deleted parent branches of branches
+// via the "git-town-branch.<branch>.previous-parent-sha" settings.
+// The next sync of these branches removes the commits of their previous parent from them.
+type PreviousParentSHAs map[gitdomain.LocalBranchName]gitdomain.SHA
@@ -0,0 +1,15 @@
+package configdomain
+
+// ProposalStackTemplate is the Go template that renders the section of proposal bodies
+// showing where a proposal sits in its stack of branches.
+// Empty means Git Town uses its built-in template.
+type ProposalStackTemplate string
+
+func (self ProposalStackTemplate) String() string {
+	return string(self)
+}
+
+func NewProposalStackTemplateRef(value string) *ProposalStackTemplate {
+	template := ProposalStackTemplate(value)
+	return &template
+}
@@ -0,0 +1,67 @@
+package configdomain
+
+import (
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/gohacks/stringslice"
+)
+
+// SharedLineageRef is the Git ref that stores the lineage shared with the other clones of the repository.
+const SharedLineageRef = "refs/git-town/lineage"
+
+// SharedLineageRefspec makes "git fetch" download the shared lineage from origin.
+// It uses a wildcard so that "git fetch" doesn't fail while origin contains no shared lineage.
+const SharedLineageRefspec = "+refs/git-town/*:refs/git-town/*"
+
+// ParseSharedLineage provides the Lineage serialized in the given content of the shared lineage ref.
+// Each line contains a branch name and the name of its parent branch, separated by a space.
+func ParseSharedLineage(text string) Lineage {
+	result := Lineage{}
+	for _, line := range stringslice.Lines(text) {
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			continue
+		}
+		result[gitdomain.NewLocalBranchName(parts[0])] = gitdomain.NewLocalBranchName(parts[1])
+	}
+	return result
+}
+
+// MissingFrom provides the entries of the given other Lineage for branches that have no parent in this Lineage.
+// It skips entries that would create a cycle in this Lineage.
+func (self Lineage) MissingFrom(other Lineage) Lineage {
+	combined := self.Merge(Lineage{})
+	result := Lineage{}
+	for _, branch := range other.BranchNames() {
+		parent := other[branch]
+		if combined.HasParents(branch) || branch == parent || combined.IsAncestor(branch, parent) {
+			continue
+		}
+		combined[branch] = parent
+		result[branch] = parent
+	}
+	return result
+}
+
+// Merge provides a new Lineage that contains the entries of the given other Lineage,
+// overridden by the entries of this Lineage.
+func (self Lineage) Merge(other Lineage) Lineage {
+	result := make(Lineage, len(self)+len(other))
+	for branch, parent := range other {
+		result[branch] = parent
+	}
+	for branch, parent := range self {
+		result[branch] = parent
+	}
+	return result
+}
+
+// SerializeShared provides the content of the shared lineage ref for this Lineage.
+func (self Lineage) SerializeShared() string {
+	result := strings.Builder{}
+	for _, branch := range self.BranchNames() {
+		result.WriteString(branch.String() + " " + self[branch].String() + "\n")
+	}
+	return result.String()
+}
@@ -0,0 +1,78 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSharedLineage(t *testing.T) {
+	t.Parallel()
+	main := gitdomain.NewLocalBranchName("main")
+	one := gitdomain.NewLocalBranchName("one")
+	two := gitdomain.NewLocalBranchName("two")
+	three := gitdomain.NewLocalBranchName("three")
+
+	t.Run("MissingFrom", func(t *testing.T) {
+		t.Parallel()
+		t.Run("adds entries for branches without parent", func(t *testing.T) {
+			t.Parallel()
+			local := configdomain.Lineage{one: main}
+			shared := configdomain.Lineage{one: main, two: one}
+			have := local.MissingFrom(shared)
+			want := configdomain.Lineage{two: one}
+			must.Eq(t, want, have)
+		})
+		t.Run("keeps the local parents", func(t *testing.T) {
+			t.Parallel()
+			local := configdomain.Lineage{two: main}
+			shared := configdomain.Lineage{two: one}
+			have := local.MissingFrom(shared)
+			want := configdomain.Lineage{}
+			must.Eq(t, want, have)
+		})
+		t.Run("skips entries that would create a cycle", func(t *testing.T) {
+			t.Parallel()
+			local := configdomain.Lineage{two: one, three: two}
+			shared := configdomain.Lineage{one: three}
+			have := local.MissingFrom(shared)
+			want := configdomain.Lineage{}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		t.Parallel()
+		local := configdomain.Lineage{one: main, two: main}
+		shared := configdomain.Lineage{two: one, three: two}
+		have := local.Merge(shared)
+		want := configdomain.Lineage{one: main, two: main, three: two}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("ParseSharedLineage", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid content", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.ParseSharedLineage("one main\ntwo one\n")
+			want := configdomain.Lineage{one: main, two: one}
+			must.Eq(t, want, have)
+		})
+		t.Run("ignores invalid lines", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.ParseSharedLineage("one main\n\ntwo\n")
+			want := configdomain.Lineage{one: main}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("SerializeShared", func(t *testing.T) {
+		t.Parallel()
+		lineage := configdomain.Lineage{two: one, one: main}
+		have := lineage.SerializeShared()
+		want := "one main\ntwo one\n"
+		must.EqOp(t, want, have)
+	})
+}
@@ -17,12 +17,15 @@ func (self SyncFeatureStrategy) StringRef() *string {
 }
 
 const (
-	SyncFeatureStrategyMerge  = SyncFeatureStrategy("merge")
-	SyncFeatureStrategyRebase = SyncFeatureStrategy("rebase")
+	SyncFeatureStrategyCompress = SyncFeatureStrategy("compress")
+	SyncFeatureStrategyMerge    = SyncFeatureStrategy("merge")
+	SyncFeatureStrategyRebase   = SyncFeatureStrategy("rebase")
 )
 
 func NewSyncFeatureStrategy(text string) (SyncFeatureStrategy, error) {
 	switch text {
+	case "compress":
+		return SyncFeatureStrategyCompress, nil
 	case "merge", "":
 		return SyncFeatureStrategyMerge, nil
 	case "rebase":
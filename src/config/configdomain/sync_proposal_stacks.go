@@ -0,0 +1,33 @@
+package configdomain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v13/src/gohacks"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// SyncProposalStacks contains the configuration setting whether "git sync" refreshes the stack sections of proposals.
+type SyncProposalStacks bool
+
+func (self SyncProposalStacks) Bool() bool {
+	return bool(self)
+}
+
+func (self SyncProposalStacks) String() string {
+	return strconv.FormatBool(self.Bool())
+}
+
+func ParseSyncProposalStacks(value, source string) (SyncProposalStacks, error) {
+	parsed, err := gohacks.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf(messages.ValueInvalid, source, value)
+	}
+	return SyncProposalStacks(parsed), nil
+}
+
+func ParseSyncProposalStacksRef(value, source string) (*SyncProposalStacks, error) {
+	result, err := ParseSyncProposalStacks(value, source)
+	return &result, err
+}
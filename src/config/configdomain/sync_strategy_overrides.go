@@ -0,0 +1,7 @@
+package configdomain
+
+import "github.com/git-town/git-town/v13/src/git/gitdomain"
+
+// SyncStrategyOverrides contains the sync-feature strategies configured for individual branches
+// via the "git-town-branch.<branch>.sync-strategy" settings.
+type SyncStrategyOverrides map[gitdomain.LocalBranchName]SyncFeatureStrategy
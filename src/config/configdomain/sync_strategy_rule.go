@@ -0,0 +1,45 @@
+package configdomain
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// SyncStrategyRule assigns a sync-feature strategy to all branches whose name matches a glob pattern.
+type SyncStrategyRule struct {
+	Branches string              // glob pattern that matches the names of the branches this rule applies to
+	Strategy SyncFeatureStrategy // the sync-feature strategy to use for these branches
+}
+
+// Matches indicates whether this rule applies to the given branch.
+func (self SyncStrategyRule) Matches(branch gitdomain.LocalBranchName) bool {
+	matches, err := path.Match(self.Branches, branch.String())
+	return err == nil && matches
+}
+
+func NewSyncStrategyRule(branches, strategy string) (SyncStrategyRule, error) {
+	if _, err := path.Match(branches, ""); err != nil {
+		return SyncStrategyRule{}, fmt.Errorf(messages.ConfigSyncStrategyRuleInvalidGlob, branches, err) //nolint:exhaustruct
+	}
+	syncStrategy, err := NewSyncFeatureStrategy(strategy)
+	return SyncStrategyRule{
+		Branches: branches,
+		Strategy: syncStrategy,
+	}, err
+}
+
+// SyncStrategyRules contains sync-feature strategy rules in the order in which they apply.
+type SyncStrategyRules []SyncStrategyRule
+
+// Find provides the first rule that applies to the given branch, or nil if no rule applies.
+func (self SyncStrategyRules) Find(branch gitdomain.LocalBranchName) *SyncStrategyRule {
+	for _, rule := range self {
+		if rule.Matches(branch) {
+			return &rule
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSyncStrategyRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewSyncStrategyRule", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid rule", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.NewSyncStrategyRule("shared/*", "rebase")
+			must.NoError(t, err)
+			want := configdomain.SyncStrategyRule{Branches: "shared/*", Strategy: configdomain.SyncFeatureStrategyRebase}
+			must.EqOp(t, want, have)
+		})
+		t.Run("invalid glob", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewSyncStrategyRule("shared/[", "rebase")
+			must.Error(t, err)
+		})
+		t.Run("unknown strategy", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.NewSyncStrategyRule("shared/*", "zonk")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		t.Parallel()
+		rule := configdomain.SyncStrategyRule{Branches: "shared/*", Strategy: configdomain.SyncFeatureStrategyRebase}
+		tests := map[string]bool{
+			"shared/one":     true,
+			"shared/one/two": false,
+			"shared":         false,
+			"feature":        false,
+		}
+		for give, want := range tests {
+			have := rule.Matches(gitdomain.NewLocalBranchName(give))
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("SyncStrategyRules.Find", func(t *testing.T) {
+		t.Parallel()
+		rules := configdomain.SyncStrategyRules{
+			{Branches: "shared/*", Strategy: configdomain.SyncFeatureStrategyMerge},
+			{Branches: "*", Strategy: configdomain.SyncFeatureStrategyRebase},
+		}
+		t.Run("first matching rule wins", func(t *testing.T) {
+			t.Parallel()
+			have := rules.Find(gitdomain.NewLocalBranchName("shared/one"))
+			must.NotNil(t, have)
+			must.EqOp(t, configdomain.SyncFeatureStrategyMerge, have.Strategy)
+		})
+		t.Run("no matching rule", func(t *testing.T) {
+			t.Parallel()
+			have := rules.Find(gitdomain.NewLocalBranchName("shared/one/two"))
+			must.Nil(t, have)
+		})
+	})
+}
@@ -4,8 +4,10 @@ package configfile
 type Data struct {
 	Branches                 *Branches     `toml:"branches"`
 	Hosting                  *Hosting      `toml:"hosting"`
+	Lineage                  Lineage       `toml:"lineage"`
 	PushHook                 *bool         `toml:"push-hook"`
 	PushNewbranches          *bool         `toml:"push-new-branches"`
+	ShareLineage             *bool         `toml:"share-lineage"`
 	ShipDeleteTrackingBranch *bool         `toml:"ship-delete-tracking-branch"`
 	SyncBeforeShip           *bool         `toml:"sync-before-ship"`
 	SyncStrategy             *SyncStrategy `toml:"sync-strategy"`
@@ -13,13 +15,23 @@ type Data struct {
 }
 
 type Branches struct {
-	Main           *string  `toml:"main"`
-	Perennials     []string `toml:"perennials"`
-	PerennialRegex *string  `toml:"perennial-regex"`
+	ContributionRegex *string          `toml:"contribution-regex"`
+	Main              *string          `toml:"main"`
+	ObservedRegex     *string          `toml:"observed-regex"`
+	Perennials        []string         `toml:"perennials"`
+	PerennialRegex    *string          `toml:"perennial-regex"`
+	Rules             []BranchTypeRule `toml:"rules"`
 }
 
 func (self Branches) IsEmpty() bool {
-	return self.Main == nil && len(self.Perennials) == 0
+	return self.Main == nil && len(self.Perennials) == 0 && self.PerennialRegex == nil && self.ContributionRegex == nil && self.ObservedRegex == nil && len(self.Rules) == 0
+}
+
+// BranchTypeRule defines the branch type of all branches matching a glob pattern or regular expression.
+type BranchTypeRule struct {
+	Branches *string `toml:"branches"`
+	Regex    *string `toml:"regex"`
+	Type     string  `toml:"type"`
 }
 
 type Hosting struct {
@@ -31,11 +43,22 @@ func (self Hosting) IsEmpty() bool {
 	return self.Platform == nil && self.OriginHostname == nil
 }
 
+// Lineage defines the parent branch of long-lived branches that all team members share.
+// branch name --> name of its parent branch
+type Lineage map[string]string
+
 type SyncStrategy struct {
-	FeatureBranches   *string `toml:"feature-branches"`
-	PerennialBranches *string `toml:"perennial-branches"`
+	FeatureBranches   *string                `toml:"feature-branches"`
+	Overrides         []SyncStrategyOverride `toml:"overrides"`
+	PerennialBranches *string                `toml:"perennial-branches"`
 }
 
 func (self SyncStrategy) IsEmpty() bool {
-	return self.FeatureBranches == nil && self.PerennialBranches == nil
+	return self.FeatureBranches == nil && len(self.Overrides) == 0 && self.PerennialBranches == nil
+}
+
+// SyncStrategyOverride defines the sync-feature strategy for all branches matching a glob pattern.
+type SyncStrategyOverride struct {
+	Branches string `toml:"branches"`
+	Strategy string `toml:"strategy"`
 }
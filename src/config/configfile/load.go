@@ -1,6 +1,7 @@
 package configfile
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -41,15 +42,31 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	result := configdomain.PartialConfig{} //nolint:exhaustruct
 	var err error
 	if data.Branches != nil {
+		if data.Branches.ContributionRegex != nil {
+			result.ContributionRegex = configdomain.NewContributionRegexRef(*data.Branches.ContributionRegex)
+		}
 		if data.Branches.Main != nil {
 			result.MainBranch = gitdomain.NewLocalBranchNameRef(*data.Branches.Main)
 		}
+		if data.Branches.ObservedRegex != nil {
+			result.ObservedRegex = configdomain.NewObservedRegexRef(*data.Branches.ObservedRegex)
+		}
 		if data.Branches.Perennials != nil {
 			result.PerennialBranches = gitdomain.NewLocalBranchNamesRef(data.Branches.Perennials...)
 		}
 		if data.Branches.PerennialRegex != nil {
 			result.PerennialRegex = configdomain.NewPerennialRegexRef(*data.Branches.PerennialRegex)
 		}
+		if len(data.Branches.Rules) > 0 {
+			rules := make(configdomain.BranchTypeRules, len(data.Branches.Rules))
+			for r, rule := range data.Branches.Rules {
+				rules[r], err = validateBranchTypeRule(rule)
+				if err != nil {
+					return result, err
+				}
+			}
+			result.BranchTypeRules = &rules
+		}
 	}
 	if data.Hosting != nil {
 		if data.Hosting.Platform != nil {
@@ -59,6 +76,19 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 			result.HostingOriginHostname = configdomain.NewHostingOriginHostnameRef(*data.Hosting.OriginHostname)
 		}
 	}
+	if len(data.Lineage) > 0 {
+		lineage := make(configdomain.Lineage, len(data.Lineage))
+		for child, parent := range data.Lineage {
+			if child == "" || parent == "" {
+				return result, errors.New(messages.ConfigFileLineageEmptyBranch)
+			}
+			if child == parent {
+				return result, fmt.Errorf(messages.ConfigFileLineageSelfParent, child)
+			}
+			lineage[gitdomain.NewLocalBranchName(child)] = gitdomain.NewLocalBranchName(parent)
+		}
+		result.Lineage = &lineage
+	}
 	if data.SyncStrategy != nil {
 		if data.SyncStrategy.FeatureBranches != nil {
 			result.SyncFeatureStrategy, err = configdomain.NewSyncFeatureStrategyRef(*data.SyncStrategy.FeatureBranches)
@@ -66,10 +96,23 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 		if data.SyncStrategy.PerennialBranches != nil {
 			result.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyRef(*data.SyncStrategy.PerennialBranches)
 		}
+		if len(data.SyncStrategy.Overrides) > 0 {
+			rules := make(configdomain.SyncStrategyRules, len(data.SyncStrategy.Overrides))
+			for i, override := range data.SyncStrategy.Overrides {
+				rules[i], err = configdomain.NewSyncStrategyRule(override.Branches, override.Strategy)
+				if err != nil {
+					return result, err
+				}
+			}
+			result.SyncStrategyRules = &rules
+		}
 	}
 	if data.PushNewbranches != nil {
 		result.PushNewBranches = configdomain.NewPushNewBranchesRef(*data.PushNewbranches)
 	}
+	if data.ShareLineage != nil {
+		result.ShareLineage = configdomain.NewShareLineageRef(*data.ShareLineage)
+	}
 	if data.ShipDeleteTrackingBranch != nil {
 		result.ShipDeleteTrackingBranch = configdomain.NewShipDeleteTrackingBranchRef(*data.ShipDeleteTrackingBranch)
 	}
@@ -81,3 +124,13 @@ func Validate(data Data) (configdomain.PartialConfig, error) {
 	}
 	return result, err
 }
+
+func validateBranchTypeRule(rule BranchTypeRule) (configdomain.BranchTypeRule, error) {
+	switch {
+	case rule.Branches != nil && rule.Regex == nil:
+		return configdomain.NewBranchTypeRule(rule.Type, *rule.Branches, false)
+	case rule.Branches == nil && rule.Regex != nil:
+		return configdomain.NewBranchTypeRule(rule.Type, *rule.Regex, true)
+	}
+	return configdomain.BranchTypeRule{}, fmt.Errorf(messages.ConfigFileRulePatternMissing, rule.Type) //nolint:exhaustruct
+}
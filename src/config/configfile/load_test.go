@@ -1,9 +1,12 @@
 package configfile_test
 
 import (
+	"regexp"
 	"testing"
 
+	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/config/configfile"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/shoenig/test/must"
 )
 
@@ -17,6 +20,7 @@ func TestConfigfile(t *testing.T) {
 			give := `
 push-hook = true
 push-new-branches = true
+share-lineage = true
 ship-delete-tracking-branch = false
 sync-before-ship = false
 sync-upstream = true
@@ -25,44 +29,81 @@ sync-upstream = true
 main = "main"
 perennials = [ "public", "staging" ]
 perennial-regex = "release-.*"
+contribution-regex = "^coworker/"
+observed-regex = "^dependabot/"
+
+[[branches.rules]]
+branches = "renovate/*"
+type = "observed"
+
+[[branches.rules]]
+regex = "^qa-\\d+$"
+type = "perennial"
 
 [hosting]
 platform = "github"
 origin-hostname = "github.com"
 
+[lineage]
+"release-2" = "main"
+"release-2-hotfixes" = "release-2"
+
 [sync-strategy]
 feature-branches = "merge"
 perennial-branches = "rebase"
+
+[[sync-strategy.overrides]]
+branches = "shared/*"
+strategy = "rebase"
 `[1:]
 			have, err := configfile.Decode(give)
 			must.NoError(t, err)
+			contributionRegex := "^coworker/"
 			github := "github"
 			githubCom := "github.com"
 			main := "main"
 			merge := "merge"
+			observedRegex := "^dependabot/"
 			pushNewBranches := true
 			pushHook := true
+			qaRegex := "^qa-\\d+$"
 			rebase := "rebase"
+			renovate := "renovate/*"
+			shareLineage := true
 			releaseRegex := "release-.*"
 			shipDeleteTrackingBranch := false
 			syncBeforeShip := false
 			syncUpstream := true
 			want := configfile.Data{
 				Branches: &configfile.Branches{
-					Main:           &main,
-					Perennials:     []string{"public", "staging"},
-					PerennialRegex: &releaseRegex,
+					ContributionRegex: &contributionRegex,
+					Main:              &main,
+					ObservedRegex:     &observedRegex,
+					Perennials:        []string{"public", "staging"},
+					PerennialRegex:    &releaseRegex,
+					Rules: []configfile.BranchTypeRule{
+						{Branches: &renovate, Regex: nil, Type: "observed"},
+						{Branches: nil, Regex: &qaRegex, Type: "perennial"},
+					},
 				},
 				Hosting: &configfile.Hosting{
 					Platform:       &github,
 					OriginHostname: &githubCom,
 				},
+				Lineage: configfile.Lineage{
+					"release-2":          "main",
+					"release-2-hotfixes": "release-2",
+				},
 				SyncStrategy: &configfile.SyncStrategy{
-					FeatureBranches:   &merge,
+					FeatureBranches: &merge,
+					Overrides: []configfile.SyncStrategyOverride{
+						{Branches: "shared/*", Strategy: "rebase"},
+					},
 					PerennialBranches: &rebase,
 				},
 				PushHook:                 &pushHook,
 				PushNewbranches:          &pushNewBranches,
+				ShareLineage:             &shareLineage,
 				ShipDeleteTrackingBranch: &shipDeleteTrackingBranch,
 				SyncBeforeShip:           &syncBeforeShip,
 				SyncUpstream:             &syncUpstream,
@@ -81,14 +122,19 @@ main = "main"
 			main := "main"
 			want := configfile.Data{
 				Branches: &configfile.Branches{
-					Main:           &main,
-					Perennials:     nil,
-					PerennialRegex: nil,
+					ContributionRegex: nil,
+					Main:              &main,
+					ObservedRegex:     nil,
+					Perennials:        nil,
+					PerennialRegex:    nil,
+					Rules:             nil,
 				},
 				Hosting:                  nil,
+				Lineage:                  nil,
 				SyncStrategy:             nil,
 				PushNewbranches:          nil,
 				PushHook:                 nil,
+				ShareLineage:             nil,
 				ShipDeleteTrackingBranch: nil,
 				SyncBeforeShip:           nil,
 				SyncUpstream:             nil,
@@ -131,4 +177,159 @@ perennials = [
 			must.Eq(t, want, *have)
 		})
 	})
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Parallel()
+		t.Run("branch regexes", func(t *testing.T) {
+			t.Parallel()
+			contributionRegex := "^coworker/"
+			observedRegex := "^dependabot/"
+			data := configfile.Data{ //nolint:exhaustruct
+				Branches: &configfile.Branches{ //nolint:exhaustruct
+					ContributionRegex: &contributionRegex,
+					ObservedRegex:     &observedRegex,
+				},
+			}
+			have, err := configfile.Validate(data)
+			must.NoError(t, err)
+			must.EqOp(t, "^coworker/", have.ContributionRegex.String())
+			must.True(t, have.ContributionRegex.MatchesBranch("coworker/feature"))
+			must.EqOp(t, "^dependabot/", have.ObservedRegex.String())
+			must.True(t, have.ObservedRegex.MatchesBranch("dependabot/npm"))
+		})
+		t.Run("invalid branch regex", func(t *testing.T) {
+			t.Parallel()
+			observedRegex := "^dependabot/("
+			data := configfile.Data{ //nolint:exhaustruct
+				Branches: &configfile.Branches{ //nolint:exhaustruct
+					ObservedRegex: &observedRegex,
+				},
+			}
+			have, err := configfile.Validate(data)
+			must.NoError(t, err)
+			must.EqOp(t, "^dependabot/(", have.ObservedRegex.String())
+			must.Error(t, have.ObservedRegex.Problem())
+			must.False(t, have.ObservedRegex.MatchesBranch("dependabot/npm"))
+		})
+		t.Run("branch type rules", func(t *testing.T) {
+			t.Parallel()
+			dependabot := "dependabot/*"
+			release := "^release-\\d+$"
+			data := configfile.Data{ //nolint:exhaustruct
+				Branches: &configfile.Branches{ //nolint:exhaustruct
+					Rules: []configfile.BranchTypeRule{
+						{Branches: &dependabot, Regex: nil, Type: "observed"},
+						{Branches: nil, Regex: &release, Type: "perennial"},
+					},
+				},
+			}
+			have, err := configfile.Validate(data)
+			must.NoError(t, err)
+			want := configdomain.BranchTypeRules{
+				{BranchType: configdomain.BranchTypeObservedBranch, Glob: true, Pattern: "dependabot/*", Regex: regexp.MustCompile("^dependabot/.*$")},
+				{BranchType: configdomain.BranchTypePerennialBranch, Glob: false, Pattern: "^release-\\d+$", Regex: regexp.MustCompile("^release-\\d+$")},
+			}
+			must.Eq(t, want, *have.BranchTypeRules)
+		})
+		t.Run("branch type rule with glob pattern and regex", func(t *testing.T) {
+			t.Parallel()
+			dependabot := "dependabot/*"
+			data := configfile.Data{ //nolint:exhaustruct
+				Branches: &configfile.Branches{ //nolint:exhaustruct
+					Rules: []configfile.BranchTypeRule{
+						{Branches: &dependabot, Regex: &dependabot, Type: "observed"},
+					},
+				},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+		t.Run("branch type rule with unknown type", func(t *testing.T) {
+			t.Parallel()
+			dependabot := "dependabot/*"
+			data := configfile.Data{ //nolint:exhaustruct
+				Branches: &configfile.Branches{ //nolint:exhaustruct
+					Rules: []configfile.BranchTypeRule{
+						{Branches: &dependabot, Regex: nil, Type: "zonk"},
+					},
+				},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+		t.Run("lineage", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				Lineage: configfile.Lineage{
+					"release-2":          "main",
+					"release-2-hotfixes": "release-2",
+				},
+			}
+			have, err := configfile.Validate(data)
+			must.NoError(t, err)
+			want := configdomain.Lineage{
+				gitdomain.NewLocalBranchName("release-2"):          gitdomain.NewLocalBranchName("main"),
+				gitdomain.NewLocalBranchName("release-2-hotfixes"): gitdomain.NewLocalBranchName("release-2"),
+			}
+			must.Eq(t, want, *have.Lineage)
+		})
+		t.Run("lineage with empty parent", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				Lineage: configfile.Lineage{"release-2": ""},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+		t.Run("lineage with branch as its own parent", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				Lineage: configfile.Lineage{"release-2": "release-2"},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+		t.Run("sync strategy overrides", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				SyncStrategy: &configfile.SyncStrategy{ //nolint:exhaustruct
+					Overrides: []configfile.SyncStrategyOverride{
+						{Branches: "shared/*", Strategy: "merge"},
+						{Branches: "*", Strategy: "rebase"},
+					},
+				},
+			}
+			have, err := configfile.Validate(data)
+			must.NoError(t, err)
+			want := configdomain.SyncStrategyRules{
+				{Branches: "shared/*", Strategy: configdomain.SyncFeatureStrategyMerge},
+				{Branches: "*", Strategy: configdomain.SyncFeatureStrategyRebase},
+			}
+			must.Eq(t, want, *have.SyncStrategyRules)
+		})
+		t.Run("invalid glob pattern", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				SyncStrategy: &configfile.SyncStrategy{ //nolint:exhaustruct
+					Overrides: []configfile.SyncStrategyOverride{
+						{Branches: "shared/[", Strategy: "merge"},
+					},
+				},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+		t.Run("unknown strategy", func(t *testing.T) {
+			t.Parallel()
+			data := configfile.Data{ //nolint:exhaustruct
+				SyncStrategy: &configfile.SyncStrategy{ //nolint:exhaustruct
+					Overrides: []configfile.SyncStrategyOverride{
+						{Branches: "shared/*", Strategy: "zonk"},
+					},
+				},
+			}
+			_, err := configfile.Validate(data)
+			must.Error(t, err)
+		})
+	})
 }
@@ -17,7 +17,51 @@ func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
 	return fmt.Sprintf(`["%s"]`, perennials.Join(`", "`))
 }
 
-func RenderTOML(config *configdomain.FullConfig) string {
+const (
+	contributionRegexHelp = `
+All branches whose names match this regular expression
+are contribution branches: you add commits to them
+but somebody else owns, proposes, and ships them.
+`
+	lineageHelp = `
+The parent branches of long-lived branches
+that all team members share.
+`
+	observedRegexHelp = `
+All branches whose names match this regular expression
+are observed branches: you follow their progress
+without adding commits to them.
+`
+)
+
+// RenderBranchTypeRules provides the TOML array of tables entries for the given branch type rules.
+func RenderBranchTypeRules(rules configdomain.BranchTypeRules) string {
+	result := strings.Builder{}
+	for _, rule := range rules {
+		result.WriteString("\n[[branches.rules]]\n")
+		if rule.IsRegex() {
+			result.WriteString(fmt.Sprintf("regex = %q\n", rule.Pattern))
+		} else {
+			result.WriteString(fmt.Sprintf("branches = %q\n", rule.Pattern))
+		}
+		result.WriteString(fmt.Sprintf("type = %q\n", rule.BranchType.Name()))
+	}
+	return result.String()
+}
+
+// RenderLineage provides the TOML table entries for the given lineage, sorted by branch name.
+func RenderLineage(lineage configdomain.Lineage) string {
+	result := strings.Builder{}
+	for _, branch := range lineage.BranchNames() {
+		result.WriteString(fmt.Sprintf("%q = %q\n", branch, lineage.Parent(branch)))
+	}
+	return result.String()
+}
+
+// RenderTOML provides the config file content for the given configuration.
+// The lineage to store in the config file is given separately
+// because the lineage in the configuration also contains the personal lineage of the user.
+func RenderTOML(config *configdomain.FullConfig, lineage configdomain.Lineage) string {
 	result := strings.Builder{}
 	result.WriteString("# Git Town configuration file\n")
 	result.WriteString("#\n")
@@ -40,7 +84,20 @@ func RenderTOML(config *configdomain.FullConfig) string {
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialBranchesHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderPerennialBranches(config.PerennialBranches)) + "\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.PerennialRegexHelp)) + "\n")
-	result.WriteString(fmt.Sprintf("perennial-regex = %q\n", config.PerennialRegex))
+	result.WriteString(fmt.Sprintf("perennial-regex = %q\n\n", config.PerennialRegex.String()))
+	result.WriteString(TOMLComment(strings.TrimSpace(contributionRegexHelp)) + "\n")
+	if config.ContributionRegex.IsEmpty() {
+		result.WriteString("# contribution-regex = \"\"\n\n")
+	} else {
+		result.WriteString(fmt.Sprintf("contribution-regex = %q\n\n", config.ContributionRegex.String()))
+	}
+	result.WriteString(TOMLComment(strings.TrimSpace(observedRegexHelp)) + "\n")
+	if config.ObservedRegex.IsEmpty() {
+		result.WriteString("# observed-regex = \"\"\n")
+	} else {
+		result.WriteString(fmt.Sprintf("observed-regex = %q\n", config.ObservedRegex.String()))
+	}
+	result.WriteString(RenderBranchTypeRules(config.BranchTypeRules))
 	result.WriteString("\n[hosting]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.HostingPlatformHelp)) + "\n")
 	if config.HostingPlatform == configdomain.HostingPlatformNone {
@@ -54,16 +111,26 @@ func RenderTOML(config *configdomain.FullConfig) string {
 	} else {
 		result.WriteString(fmt.Sprintf("origin-hostname = %q\n", config.HostingOriginHostname))
 	}
+	if len(lineage) > 0 {
+		result.WriteString("\n[lineage]\n\n")
+		result.WriteString(TOMLComment(strings.TrimSpace(lineageHelp)) + "\n")
+		result.WriteString(RenderLineage(lineage))
+	}
 	result.WriteString("\n[sync-strategy]\n\n")
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncFeatureStrategyHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("feature-branches = %q\n\n", config.SyncFeatureStrategy))
 	result.WriteString(TOMLComment(strings.TrimSpace(dialog.SyncPerennialStrategyHelp)) + "\n")
 	result.WriteString(fmt.Sprintf("perennial-branches = %q\n", config.SyncPerennialStrategy))
+	for _, rule := range config.SyncStrategyRules {
+		result.WriteString("\n[[sync-strategy.overrides]]\n")
+		result.WriteString(fmt.Sprintf("branches = %q\n", rule.Branches))
+		result.WriteString(fmt.Sprintf("strategy = %q\n", rule.Strategy))
+	}
 	return result.String()
 }
 
-func Save(config *configdomain.FullConfig) error {
-	return os.WriteFile(FileName, []byte(RenderTOML(config)), 0o600)
+func Save(config *configdomain.FullConfig, lineage configdomain.Lineage) error {
+	return os.WriteFile(FileName, []byte(RenderTOML(config, lineage)), 0o600)
 }
 
 func TOMLComment(text string) string {
@@ -2,6 +2,7 @@ package configfile_test
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/git-town/git-town/v13/src/config/configdomain"
@@ -38,12 +39,62 @@ func TestSave(t *testing.T) {
 		})
 	})
 
+	t.Run("RenderBranchTypeRules", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no rules", func(t *testing.T) {
+			t.Parallel()
+			have := configfile.RenderBranchTypeRules(configdomain.BranchTypeRules{})
+			must.EqOp(t, "", have)
+		})
+		t.Run("glob pattern and regex", func(t *testing.T) {
+			t.Parallel()
+			give := configdomain.BranchTypeRules{
+				{BranchType: configdomain.BranchTypeObservedBranch, Glob: true, Pattern: "dependabot/*", Regex: regexp.MustCompile("^dependabot/.*$")},
+				{BranchType: configdomain.BranchTypePerennialBranch, Glob: false, Pattern: "^release-\\d+$", Regex: regexp.MustCompile("^release-\\d+$")},
+			}
+			have := configfile.RenderBranchTypeRules(give)
+			want := `
+
+[[branches.rules]]
+branches = "dependabot/*"
+type = "observed"
+
+[[branches.rules]]
+regex = "^release-\\d+$"
+type = "perennial"
+`[1:]
+			must.EqOp(t, want, have)
+		})
+	})
+
+	t.Run("RenderLineage", func(t *testing.T) {
+		t.Parallel()
+		t.Run("empty lineage", func(t *testing.T) {
+			t.Parallel()
+			have := configfile.RenderLineage(configdomain.Lineage{})
+			must.EqOp(t, "", have)
+		})
+		t.Run("multiple entries", func(t *testing.T) {
+			t.Parallel()
+			give := configdomain.Lineage{
+				gitdomain.NewLocalBranchName("release-2-hotfixes"): gitdomain.NewLocalBranchName("release-2"),
+				gitdomain.NewLocalBranchName("release-2"):          gitdomain.NewLocalBranchName("main"),
+			}
+			have := configfile.RenderLineage(give)
+			want := `
+"release-2" = "main"
+"release-2-hotfixes" = "release-2"
+`[1:]
+			must.EqOp(t, want, have)
+		})
+	})
+
 	t.Run("RenderTOML", func(t *testing.T) {
 		t.Parallel()
 		give := configdomain.DefaultConfig()
 		give.MainBranch = gitdomain.NewLocalBranchName("main")
 		give.PerennialBranches = gitdomain.NewLocalBranchNames("one", "two")
-		have := configfile.RenderTOML(&give)
+		have := configfile.RenderTOML(&give, configdomain.Lineage{})
 		want := `
 # Git Town configuration file
 #
@@ -121,6 +172,16 @@ perennials = ["one", "two"]
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are contribution branches: you add commits to them
+# but somebody else owns, proposes, and ships them.
+# contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are observed branches: you follow their progress
+# without adding commits to them.
+# observed-regex = ""
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
@@ -156,7 +217,7 @@ perennial-branches = "rebase"
 		t.Parallel()
 		give := configdomain.DefaultConfig()
 		give.MainBranch = gitdomain.NewLocalBranchName("main")
-		err := configfile.Save(&give)
+		err := configfile.Save(&give, configdomain.Lineage{})
 		defer os.Remove(configfile.FileName)
 		must.NoError(t, err)
 		bytes, err := os.ReadFile(configfile.FileName)
@@ -239,6 +300,16 @@ perennials = []
 # If you are not sure, leave this empty.
 perennial-regex = ""
 
+# All branches whose names match this regular expression
+# are contribution branches: you add commits to them
+# but somebody else owns, proposes, and ships them.
+# contribution-regex = ""
+
+# All branches whose names match this regular expression
+# are observed branches: you follow their progress
+# without adding commits to them.
+# observed-regex = ""
+
 [hosting]
 
 # Knowing the type of code hosting platform allows Git Town
@@ -32,6 +32,26 @@ func (self *Access) LoadLocal() (SingleSnapshot, configdomain.PartialConfig, err
 }
 
 func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialConfig) error {
+	if branchName, isSyncStrategyKey := strings.CutSuffix(key.String(), ".sync-strategy"); isSyncStrategyKey && strings.HasPrefix(branchName, "git-town-branch.") {
+		if config.SyncStrategyOverrides == nil {
+			config.SyncStrategyOverrides = &configdomain.SyncStrategyOverrides{}
+		}
+		branch := gitdomain.NewLocalBranchName(strings.TrimPrefix(branchName, "git-town-branch."))
+		strategy, err := configdomain.NewSyncFeatureStrategy(value)
+		if err != nil {
+			return err
+		}
+		(*config.SyncStrategyOverrides)[branch] = strategy
+		return nil
+	}
+	if branchName, isPreviousParentSHAKey := strings.CutSuffix(key.String(), ".previous-parent-sha"); isPreviousParentSHAKey && strings.HasPrefix(branchName, "git-town-branch.") {
+		if config.PreviousParentSHAs == nil {
+			config.PreviousParentSHAs = &configdomain.PreviousParentSHAs{}
+		}
+		branch := gitdomain.NewLocalBranchName(strings.TrimPrefix(branchName, "git-town-branch."))
+		(*config.PreviousParentSHAs)[branch] = gitdomain.NewSHA(value)
+		return nil
+	}
 	if strings.HasPrefix(key.String(), "git-town-branch.") {
 		if config.Lineage == nil {
 			config.Lineage = &configdomain.Lineage{}
@@ -71,8 +91,18 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.Aliases[configdomain.AliasableCommandShip] = value
 	case KeyAliasSync:
 		config.Aliases[configdomain.AliasableCommandSync] = value
+	case KeyAzureDevOpsToken:
+		config.AzureDevOpsToken = configdomain.NewAzureDevOpsTokenRef(value)
+	case KeyBitbucketAppPassword:
+		config.BitbucketAppPassword = configdomain.NewBitbucketAppPasswordRef(value)
+	case KeyBitbucketUsername:
+		config.BitbucketUsername = configdomain.NewBitbucketUsernameRef(value)
+	case KeyBranchTypeRules:
+		config.BranchTypeRules, err = configdomain.ParseBranchTypeRulesRef(value)
 	case KeyContributionBranches:
 		config.ContributionBranches = gitdomain.ParseLocalBranchNamesRef(value)
+	case KeyHostingConnectorCommand:
+		config.HostingConnectorCommand = configdomain.NewHostingConnectorCommandRef(value)
 	case KeyHostingOriginHostname:
 		config.HostingOriginHostname = configdomain.NewHostingOriginHostnameRef(value)
 	case KeyHostingPlatform:
@@ -99,10 +129,16 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.PerennialBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPerennialRegex:
 		config.PerennialRegex = configdomain.NewPerennialRegexRef(value)
+	case KeyProposalStackTemplate:
+		config.ProposalStackTemplate = configdomain.NewProposalStackTemplateRef(value)
+	case KeyPrototypeBranches:
+		config.PrototypeBranches = gitdomain.ParseLocalBranchNamesRef(value)
 	case KeyPushHook:
 		config.PushHook, err = configdomain.NewPushHookRef(value, KeyPushHook.String())
 	case KeyPushNewBranches:
 		config.PushNewBranches, err = configdomain.ParsePushNewBranchesRef(value, KeyPushNewBranches.String())
+	case KeyShareLineage:
+		config.ShareLineage, err = configdomain.ParseShareLineageRef(value, KeyShareLineage.String())
 	case KeyShipDeleteTrackingBranch:
 		config.ShipDeleteTrackingBranch, err = configdomain.ParseShipDeleteTrackingBranchRef(value, KeyShipDeleteTrackingBranch.String())
 	case KeySyncBeforeShip:
@@ -111,6 +147,8 @@ func AddKeyToPartialConfig(key Key, value string, config *configdomain.PartialCo
 		config.SyncFeatureStrategy, err = configdomain.NewSyncFeatureStrategyRef(value)
 	case KeySyncPerennialStrategy:
 		config.SyncPerennialStrategy, err = configdomain.NewSyncPerennialStrategyRef(value)
+	case KeySyncProposalStacks:
+		config.SyncProposalStacks, err = configdomain.ParseSyncProposalStacksRef(value, KeySyncProposalStacks.String())
 	case KeySyncUpstream:
 		config.SyncUpstream, err = configdomain.ParseSyncUpstreamRef(value, KeySyncUpstream.String())
 	case KeyDeprecatedCodeHostingDriver,
@@ -144,7 +182,7 @@ func (self *Access) RemoveLocalConfigValue(key Key) error {
 }
 
 // RemoveLocalGitConfiguration removes all Git Town configuration.
-func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) error {
+func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage, syncStrategyOverrides configdomain.SyncStrategyOverrides, previousParentSHAs configdomain.PreviousParentSHAs) error {
 	err := self.Run("git", "config", "--remove-section", "git-town")
 	if err != nil {
 		var exitErr *exec.ExitError
@@ -164,6 +202,18 @@ func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) er
 			return fmt.Errorf(messages.ConfigRemoveError, err)
 		}
 	}
+	for branch := range syncStrategyOverrides {
+		err = self.RemoveLocalConfigValue(NewSyncStrategyKey(branch))
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
+	for branch := range previousParentSHAs {
+		err = self.RemoveLocalConfigValue(NewPreviousParentSHAKey(branch))
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
 	return nil
 }
 
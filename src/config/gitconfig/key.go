@@ -42,6 +42,10 @@ const (
 	KeyAliasSetParent                      = Key("alias.set-parent")
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
+	KeyAzureDevOpsToken                    = Key("git-town.azure-devops-token")
+	KeyBitbucketAppPassword                = Key("git-town.bitbucket-app-password")
+	KeyBitbucketUsername                   = Key("git-town.bitbucket-username")
+	KeyBranchTypeRules                     = Key("git-town.branch-type-rules")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
 	KeyDeprecatedCodeHostingDriver         = Key("git-town.code-hosting-driver")
 	KeyDeprecatedCodeHostingOriginHostname = Key("git-town.code-hosting-origin-hostname")
@@ -56,6 +60,7 @@ const (
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key("git-town.github-token")
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
+	KeyHostingConnectorCommand             = Key("git-town.hosting-connector-command")
 	KeyHostingOriginHostname               = Key("git-town.hosting-origin-hostname")
 	KeyHostingPlatform                     = Key("git-town.hosting-platform")
 	KeyMainBranch                          = Key("git-town.main-branch")
@@ -64,12 +69,16 @@ const (
 	KeyParkedBranches                      = Key("git-town.parked-branches")
 	KeyPerennialBranches                   = Key("git-town.perennial-branches")
 	KeyPerennialRegex                      = Key("git-town.perennial-regex")
+	KeyProposalStackTemplate               = Key("git-town.proposal-stack-template")
+	KeyPrototypeBranches                   = Key("git-town.prototype-branches")
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
+	KeyShareLineage                        = Key("git-town.share-lineage")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
 	KeySyncBeforeShip                      = Key("git-town.sync-before-ship")
 	KeySyncFeatureStrategy                 = Key("git-town.sync-feature-strategy")
 	KeySyncPerennialStrategy               = Key("git-town.sync-perennial-strategy")
+	KeySyncProposalStacks                  = Key("git-town.sync-proposal-stacks")
 	KeySyncStrategy                        = Key("git-town.sync-strategy")
 	KeySyncUpstream                        = Key("git-town.sync-upstream")
 	KeyGitUserEmail                        = Key("user.email")
@@ -77,8 +86,13 @@ const (
 )
 
 var keys = []Key{ //nolint:gochecknoglobals
+	KeyHostingConnectorCommand,
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
+	KeyAzureDevOpsToken,
+	KeyBitbucketAppPassword,
+	KeyBitbucketUsername,
+	KeyBranchTypeRules,
 	KeyContributionBranches,
 	KeyDeprecatedCodeHostingDriver,
 	KeyDeprecatedCodeHostingOriginHostname,
@@ -101,12 +115,16 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyParkedBranches,
 	KeyPerennialBranches,
 	KeyPerennialRegex,
+	KeyProposalStackTemplate,
+	KeyPrototypeBranches,
 	KeyPushHook,
 	KeyPushNewBranches,
+	KeyShareLineage,
 	KeyShipDeleteTrackingBranch,
 	KeySyncBeforeShip,
 	KeySyncFeatureStrategy,
 	KeySyncPerennialStrategy,
+	KeySyncProposalStacks,
 	KeySyncStrategy,
 	KeySyncUpstream,
 }
@@ -158,6 +176,14 @@ func NewParentKey(branch gitdomain.LocalBranchName) Key {
 	return Key(fmt.Sprintf("git-town-branch.%s.parent", branch))
 }
 
+func NewPreviousParentSHAKey(branch gitdomain.LocalBranchName) Key {
+	return Key(fmt.Sprintf("git-town-branch.%s.previous-parent-sha", branch))
+}
+
+func NewSyncStrategyKey(branch gitdomain.LocalBranchName) Key {
+	return Key(fmt.Sprintf("git-town-branch.%s.sync-strategy", branch))
+}
+
 func ParseKey(name string) *Key {
 	for _, configKey := range keys {
 		if configKey.String() == name {
@@ -168,6 +194,14 @@ func ParseKey(name string) *Key {
 	if lineageKey != nil {
 		return lineageKey
 	}
+	syncStrategyKey := parseSyncStrategyKey(name)
+	if syncStrategyKey != nil {
+		return syncStrategyKey
+	}
+	previousParentSHAKey := parsePreviousParentSHAKey(name)
+	if previousParentSHAKey != nil {
+		return previousParentSHAKey
+	}
 	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
 		key := KeyForAliasableCommand(aliasableCommand)
 		if key.String() == name {
@@ -185,6 +219,22 @@ func parseLineageKey(key string) *Key {
 	return &result
 }
 
+func parsePreviousParentSHAKey(key string) *Key {
+	if !strings.HasPrefix(key, "git-town-branch.") || !strings.HasSuffix(key, ".previous-parent-sha") {
+		return nil
+	}
+	result := Key(key)
+	return &result
+}
+
+func parseSyncStrategyKey(key string) *Key {
+	if !strings.HasPrefix(key, "git-town-branch.") || !strings.HasSuffix(key, ".sync-strategy") {
+		return nil
+	}
+	result := Key(key)
+	return &result
+}
+
 // DeprecatedKeys defines the up-to-date counterparts to deprecated configuration settings.
 var DeprecatedKeys = map[Key]Key{ //nolint:gochecknoglobals
 	KeyDeprecatedCodeHostingDriver:         KeyHostingPlatform,
@@ -38,6 +38,36 @@ func TestKey(t *testing.T) {
 				must.Nil(t, have)
 			})
 		})
+		t.Run("sync strategy keys", func(t *testing.T) {
+			t.Parallel()
+			t.Run("valid sync strategy key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.sync-strategy"
+				have := gitconfig.ParseKey(give)
+				want := gitconfig.NewSyncStrategyKey("branch-1")
+				must.EqOp(t, want, *have)
+			})
+			t.Run("sync strategy key without prefix", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("git-town.branch-1.sync-strategy")
+				must.Nil(t, have)
+			})
+		})
+		t.Run("previous parent SHA keys", func(t *testing.T) {
+			t.Parallel()
+			t.Run("valid previous parent SHA key", func(t *testing.T) {
+				t.Parallel()
+				give := "git-town-branch.branch-1.previous-parent-sha"
+				have := gitconfig.ParseKey(give)
+				want := gitconfig.NewPreviousParentSHAKey("branch-1")
+				must.EqOp(t, want, *have)
+			})
+			t.Run("previous parent SHA key without prefix", func(t *testing.T) {
+				t.Parallel()
+				have := gitconfig.ParseKey("git-town.branch-1.previous-parent-sha")
+				must.Nil(t, have)
+			})
+		})
 		t.Run("alias key", func(t *testing.T) {
 			t.Parallel()
 			t.Run("valid alias", func(t *testing.T) {
@@ -2,14 +2,17 @@ package execute
 
 import (
 	"github.com/git-town/git-town/v13/src/cli/dialog/components"
+	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v13/src/validate"
 )
 
 // EnsureKnownBranchAncestry makes sure the lineage for the given branch is known.
-// If needed, it queries the user for missing information.
+// If needed, it infers or queries the user for missing information.
 // It returns the updated version of all information that is derived from the lineage.
 //
 // The purpose of this function is to implement proper cache invalidation.
@@ -19,8 +22,11 @@ func EnsureKnownBranchAncestry(branch gitdomain.LocalBranchName, args EnsureKnow
 		LocalBranches:    args.AllBranches.Names(),
 		Backend:          &args.Runner.Backend,
 		Config:           args.Config,
+		Connector:        newParentInferenceConnector(args.Runner, args.InferParents),
 		DialogTestInputs: args.DialogTestInputs,
+		InferParents:     args.InferParents,
 		MainBranch:       args.DefaultBranch,
+		Yes:              args.Yes,
 	})
 	if err != nil {
 		return err
@@ -37,5 +43,53 @@ type EnsureKnownBranchAncestryArgs struct {
 	Config           *configdomain.FullConfig
 	DefaultBranch    gitdomain.LocalBranchName
 	DialogTestInputs *components.TestInputs
+	InferParents     bool // whether to preselect inferred parent branches when asking the user
 	Runner           *git.ProdRunner
+	Yes              bool // whether to apply inferred parent branches without asking the user
+}
+
+// parentInferenceConnector creates the connector for inferring parent branches from proposals on first use.
+// Inferring parents from proposals is optional, so it provides nil if no connector is available.
+type parentInferenceConnector struct {
+	connector    hostingdomain.Connector
+	created      bool
+	inferParents bool
+	runner       *git.ProdRunner
+}
+
+func newParentInferenceConnector(runner *git.ProdRunner, inferParents bool) *parentInferenceConnector {
+	return &parentInferenceConnector{
+		connector:    nil,
+		created:      false,
+		inferParents: inferParents,
+		runner:       runner,
+	}
+}
+
+func (self *parentInferenceConnector) Connector() hostingdomain.Connector {
+	if !self.created {
+		self.connector = self.create()
+		self.created = true
+	}
+	return self.connector
+}
+
+func (self *parentInferenceConnector) create() hostingdomain.Connector {
+	if !self.inferParents || self.runner.Config.FullConfig.Offline.Bool() {
+		return nil
+	}
+	originURL := self.runner.Config.OriginURL()
+	if originURL == nil {
+		return nil
+	}
+	connector, err := hosting.NewConnector(hosting.NewConnectorArgs{
+		FullConfig:      &self.runner.Config.FullConfig,
+		HostingPlatform: self.runner.Config.FullConfig.HostingPlatform,
+		Log:             print.Logger{},
+		OriginURL:       originURL,
+	})
+	if err != nil {
+		return nil
+	}
+	return connector
 }
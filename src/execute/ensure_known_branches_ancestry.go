@@ -9,7 +9,7 @@ import (
 )
 
 // EnsureKnownBranchesAncestry makes sure the entire repo lineage is known.
-// If needed, it queries the user for missing information.
+// If needed, it infers or queries the user for missing information.
 // It returns the updated version of all information that is derived from the lineage.
 //
 // The purpose of this function is to implement proper cache invalidation.
@@ -18,8 +18,10 @@ func EnsureKnownBranchesAncestry(args EnsureKnownBranchesAncestryArgs) error {
 	updated, err := validate.KnowsBranchesAncestors(validate.KnowsBranchesAncestorsArgs{
 		Backend:          &args.Runner.Backend,
 		Config:           args.Config,
+		Connector:        newParentInferenceConnector(args.Runner, true),
 		DialogTestInputs: args.DialogTestInputs,
 		LocalBranches:    args.LocalBranches,
+		Yes:              args.Yes,
 	})
 	if err != nil {
 		return err
@@ -35,4 +37,5 @@ type EnsureKnownBranchesAncestryArgs struct {
 	DialogTestInputs *components.TestInputs
 	LocalBranches    gitdomain.BranchInfos
 	Runner           *git.ProdRunner
+	Yes              bool
 }
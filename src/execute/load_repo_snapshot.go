@@ -1,29 +1,35 @@
 package execute
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/git-town/git-town/v13/src/cli/dialog/components"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/config/gitconfig"
+	"github.com/git-town/git-town/v13/src/git"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/subshell"
 	"github.com/git-town/git-town/v13/src/validate"
 )
 
 // LoadRepoSnapshot loads the initial snapshot of the Git repo.
 func LoadRepoSnapshot(args LoadRepoSnapshotArgs) (gitdomain.BranchesSnapshot, gitdomain.StashSize, gitdomain.RepoStatus, bool, error) {
-	var branchesSnapshot gitdomain.BranchesSnapshot
-	var err error
-	stashSize, err := args.Repo.Runner.Backend.StashSize()
-	if err != nil {
-		return gitdomain.EmptyBranchesSnapshot(), stashSize, gitdomain.RepoStatus{}, false, err
-	}
-	repoStatus, err := args.Repo.Runner.Backend.RepoStatus()
+	timings := snapshotTimings{}
+	start := time.Now()
+	localState, err := loadLocalState(args.Repo, args.HandleUnfinishedState, args.Fetch, args.Verbose)
+	timings.localState = time.Since(start)
+	branchesSnapshot := localState.branchesSnapshot
+	stashSize := localState.stashSize
+	repoStatus := localState.repoStatus
 	if err != nil {
 		return branchesSnapshot, stashSize, repoStatus, false, err
 	}
 	if args.HandleUnfinishedState {
-		branchesSnapshot, err = args.Repo.Runner.Backend.BranchesSnapshot()
-		if err != nil {
-			return branchesSnapshot, stashSize, repoStatus, false, err
-		}
 		exit, err := validate.HandleUnfinishedState(validate.UnfinishedStateArgs{
 			Connector:               nil,
 			CurrentBranch:           branchesSnapshot.Active,
@@ -49,27 +55,43 @@ func LoadRepoSnapshot(args LoadRepoSnapshotArgs) (gitdomain.BranchesSnapshot, gi
 		}
 	}
 	if args.Fetch {
-		var remotes gitdomain.Remotes
-		remotes, err := args.Repo.Runner.Backend.Remotes()
-		if err != nil {
-			return branchesSnapshot, stashSize, repoStatus, false, err
-		}
-		if remotes.HasOrigin() && !args.Repo.IsOffline.Bool() {
-			err = args.Repo.Runner.Frontend.Fetch()
+		if localState.remotes.HasOrigin() && !args.Repo.IsOffline.Bool() {
+			start = time.Now()
+			if len(localState.remotes) > 1 {
+				err = args.Repo.Runner.Frontend.FetchRemotes(localState.remotes)
+			} else {
+				err = args.Repo.Runner.Frontend.Fetch()
+			}
+			timings.fetch = time.Since(start)
 			if err != nil {
 				return branchesSnapshot, stashSize, repoStatus, false, err
 			}
 		}
+		// the fetch has updated the tracking branches
+		branchesSnapshot = gitdomain.EmptyBranchesSnapshot()
+	}
+	if branchesSnapshot.IsEmpty() {
+		start = time.Now()
 		branchesSnapshot, err = args.Repo.Runner.Backend.BranchesSnapshot()
+		timings.branches = time.Since(start)
 		if err != nil {
 			return branchesSnapshot, stashSize, repoStatus, false, err
 		}
 	}
-	if branchesSnapshot.IsEmpty() {
-		branchesSnapshot, err = args.Repo.Runner.Backend.BranchesSnapshot()
+	if args.Verbose {
+		timings.print()
+	}
+	if args.Repo.Runner.Config.FullConfig.ShareLineage {
+		var added configdomain.Lineage
+		added, err = MergeSharedLineage(args.Repo.Runner, branchesSnapshot.Branches)
 		if err != nil {
 			return branchesSnapshot, stashSize, repoStatus, false, err
 		}
+		// The parent branches from the shared lineage are not a change made by the current command,
+		// so they become part of the initial config snapshot and undoing this command keeps them.
+		for branch, parent := range added {
+			args.Repo.ConfigSnapshot.Local[gitconfig.NewParentKey(branch)] = parent.String()
+		}
 	}
 	if args.ValidateIsConfigured {
 		err = validate.IsConfigured(&args.Repo.Runner.Backend, args.FullConfig, branchesSnapshot.Branches.LocalBranches().Names(), &args.DialogTestInputs)
@@ -87,3 +109,78 @@ type LoadRepoSnapshotArgs struct {
 	ValidateNoOpenChanges bool
 	Verbose               bool
 }
+
+// localState describes the parts of the Git repo that can be determined without talking to a remote.
+type localState struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	remotes          gitdomain.Remotes
+	repoStatus       gitdomain.RepoStatus
+	stashSize        gitdomain.StashSize
+}
+
+// loadLocalState determines the stash size, the status, and optionally the branches and remotes of the given repo.
+// These queries don't depend on each other, so it runs them concurrently
+// and prints their verbose output in a deterministic order once they are all done.
+func loadLocalState(repo *OpenRepoResult, loadBranches, loadRemotes, verbose bool) (localState, error) {
+	result := localState{} //nolint:exhaustruct
+	queries := []func(*git.BackendCommands) error{
+		func(backend *git.BackendCommands) (err error) {
+			result.stashSize, err = backend.StashSize()
+			return err
+		},
+		func(backend *git.BackendCommands) (err error) {
+			result.repoStatus, err = backend.RepoStatus()
+			return err
+		},
+	}
+	if loadBranches {
+		queries = append(queries, func(backend *git.BackendCommands) (err error) {
+			result.branchesSnapshot, err = backend.BranchesSnapshot()
+			return err
+		})
+	}
+	if loadRemotes {
+		queries = append(queries, func(backend *git.BackendCommands) (err error) {
+			result.remotes, err = backend.Remotes()
+			return err
+		})
+	}
+	outputs := make([]strings.Builder, len(queries))
+	errs := make([]error, len(queries))
+	wg := sync.WaitGroup{}
+	for q, query := range queries {
+		backend := repo.Runner.Backend
+		backend.Runner = subshell.BackendRunner{
+			CommandsCounter: repo.Runner.CommandsCounter,
+			Dir:             nil,
+			Output:          &outputs[q],
+			Verbose:         verbose,
+		}
+		wg.Add(1)
+		go func(q int, query func(*git.BackendCommands) error) {
+			defer wg.Done()
+			errs[q] = query(&backend)
+		}(q, query)
+	}
+	wg.Wait()
+	for o := range outputs {
+		fmt.Print(outputs[o].String())
+	}
+	return result, errors.Join(errs...)
+}
+
+// snapshotTimings contains how long the phases of loading the repo snapshot took.
+type snapshotTimings struct {
+	branches   time.Duration // loading the branches after the fetch, zero if the branches loaded with the local state are still valid
+	fetch      time.Duration // fetching updates from the remotes, zero if no fetch happened
+	localState time.Duration // determining the stash size, status, remotes, and branches of the repo
+}
+
+func (self snapshotTimings) print() {
+	total := self.localState + self.fetch + self.branches
+	fmt.Printf(messages.RepoSnapshotTimings, round(total), round(self.localState), round(self.fetch), round(self.branches))
+}
+
+func round(duration time.Duration) time.Duration {
+	return duration.Round(time.Millisecond)
+}
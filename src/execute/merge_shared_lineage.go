@@ -0,0 +1,31 @@
+package execute
+
+import (
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+)
+
+// MergeSharedLineage stores the parent branches from the shared lineage
+// for the local branches whose parent the local configuration doesn't know.
+// Parent branches in the local configuration take precedence over the shared lineage.
+// It returns the parent branches that it added to the local configuration.
+func MergeSharedLineage(runner *git.ProdRunner, branches gitdomain.BranchInfos) (configdomain.Lineage, error) {
+	result := configdomain.Lineage{}
+	sharedLineage, exists := runner.Backend.SharedLineage()
+	if !exists {
+		return result, nil
+	}
+	missing := runner.Config.FullConfig.Lineage.MissingFrom(sharedLineage)
+	for _, branch := range missing.BranchNames() {
+		if !branches.HasLocalBranch(branch) || runner.Config.FullConfig.IsMainOrPerennialBranch(branch) {
+			continue
+		}
+		err := runner.Config.SetParent(branch, missing[branch])
+		if err != nil {
+			return result, err
+		}
+		result[branch] = missing[branch]
+	}
+	return result, nil
+}
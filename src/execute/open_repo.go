@@ -23,14 +23,18 @@ func OpenRepo(args OpenRepoArgs) (*OpenRepoResult, error) {
 	backendRunner := subshell.BackendRunner{
 		Dir:             nil,
 		CommandsCounter: &commandsCounter,
+		Output:          nil,
 		Verbose:         args.Verbose,
 	}
+	commitCountsCache := cache.CommitCounts{}
 	backendCommands := git.BackendCommands{
 		Runner:             backendRunner,
 		DryRun:             args.DryRun,
+		CommitCountsCache:  &commitCountsCache,
 		Config:             nil, // initializing to nil here to validate the Git version before running any Git commands, setting to the correct value after that is done
 		CurrentBranchCache: &cache.LocalBranchWithPrevious{},
 		RemotesCache:       &cache.Remotes{},
+		VersionCache:       &cache.GitVersion{},
 	}
 	gitVersionMajor, gitVersionMinor, err := backendCommands.Version()
 	if err != nil {
@@ -73,11 +77,12 @@ func OpenRepo(args OpenRepoArgs) (*OpenRepoResult, error) {
 		Backend: backendCommands,
 		Frontend: git.FrontendCommands{
 			Runner: newFrontendRunner(newFrontendRunnerArgs{
-				counter:          &commandsCounter,
-				dryRun:           args.DryRun,
-				getCurrentBranch: backendCommands.CurrentBranch,
-				omitBranchNames:  args.OmitBranchNames,
-				printCommands:    args.PrintCommands,
+				commitCountsCache: &commitCountsCache,
+				counter:           &commandsCounter,
+				dryRun:            args.DryRun,
+				getCurrentBranch:  backendCommands.CurrentBranch,
+				omitBranchNames:   args.OmitBranchNames,
+				printCommands:     args.PrintCommands,
 			}),
 			SetCachedCurrentBranch: backendCommands.CurrentBranchCache.Set,
 		},
@@ -142,17 +147,19 @@ func newFrontendRunner(args newFrontendRunnerArgs) git.FrontendRunner {
 		}
 	}
 	return &subshell.FrontendRunner{
-		GetCurrentBranch: args.getCurrentBranch,
-		OmitBranchNames:  args.omitBranchNames,
-		PrintCommands:    args.printCommands,
-		CommandsCounter:  args.counter,
+		CommitCountsCache: args.commitCountsCache,
+		GetCurrentBranch:  args.getCurrentBranch,
+		OmitBranchNames:   args.omitBranchNames,
+		PrintCommands:     args.printCommands,
+		CommandsCounter:   args.counter,
 	}
 }
 
 type newFrontendRunnerArgs struct {
-	counter          *gohacks.Counter
-	dryRun           bool
-	getCurrentBranch subshell.GetCurrentBranchFunc
-	omitBranchNames  bool
-	printCommands    bool
+	commitCountsCache *cache.CommitCounts
+	counter           *gohacks.Counter
+	dryRun            bool
+	getCurrentBranch  subshell.GetCurrentBranchFunc
+	omitBranchNames   bool
+	printCommands     bool
 }
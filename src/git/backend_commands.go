@@ -6,10 +6,12 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/git-town/git-town/v13/src/config"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/gohacks/cache"
 	"github.com/git-town/git-town/v13/src/gohacks/stringslice"
@@ -27,11 +29,13 @@ type BackendRunner interface {
 // They don't change the user's repo, execute instantaneously, and Git Town needs to know their output.
 // They are invisible to the end user unless the "verbose" option is set.
 type BackendCommands struct {
+	CommitCountsCache  *cache.CommitCounts            // caches how many commits branches are ahead of and behind other branches
 	Config             *config.Config                 // the known state of the Git repository
 	CurrentBranchCache *cache.LocalBranchWithPrevious // caches the currently checked out Git branch
 	DryRun             bool
-	RemotesCache       *cache.Remotes // caches Git remotes
-	Runner             BackendRunner  // executes shell commands in the directory of the Git repo
+	RemotesCache       *cache.Remotes    // caches Git remotes
+	Runner             BackendRunner     // executes shell commands in the directory of the Git repo
+	VersionCache       *cache.GitVersion // caches the version of the installed Git executable
 }
 
 // Author provides the locally Git configured user.
@@ -186,6 +190,104 @@ func (self *BackendCommands) CommentOutSquashCommitMessage(prefix string) error
 	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
 }
 
+// CommitCounts provides how many commits the given branch has that the other branch doesn't have,
+// and how many commits the other branch has that the given branch doesn't have.
+func (self *BackendCommands) CommitCounts(branch, other gitdomain.BranchName) (ahead, behind int, err error) { //nolint:nonamedreturns
+	output, err := self.Runner.QueryTrim("git", "rev-list", "--left-right", "--count", branch.String()+"..."+other.String())
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.CommitCountsProblem, branch, other, err)
+	}
+	counts := strings.Fields(output)
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf(messages.CommitCountsUnexpectedOutput, branch, other, output)
+	}
+	ahead, err = strconv.Atoi(counts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.CommitCountsUnexpectedOutput, branch, other, output)
+	}
+	behind, err = strconv.Atoi(counts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.CommitCountsUnexpectedOutput, branch, other, output)
+	}
+	return ahead, behind, nil
+}
+
+// CommitCountsAgainst provides how many commits each of the given branches is ahead of and behind the given base branch.
+// Git 2.41 and newer determine this for all branches using a single Git command,
+// older Git versions require one Git command per branch.
+// The results are cached until Git Town runs the next frontend command.
+func (self *BackendCommands) CommitCountsAgainst(base gitdomain.LocalBranchName, branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	result := make(map[gitdomain.LocalBranchName]gitdomain.AheadBehind, len(branches))
+	uncached := gitdomain.LocalBranchNames{}
+	for _, branch := range branches {
+		if counts, has := self.CommitCountsCache.Get(base, branch); has {
+			result[branch] = counts
+		} else {
+			uncached = append(uncached, branch)
+		}
+	}
+	if len(uncached) == 0 {
+		return result, nil
+	}
+	loaded, err := self.CommitCountsAgainstUncached(base, uncached)
+	if err != nil {
+		return result, err
+	}
+	for branch, counts := range loaded {
+		self.CommitCountsCache.Set(base, branch, counts)
+		result[branch] = counts
+	}
+	return result, nil
+}
+
+// CommitCountsAgainstUncached provides how many commits each of the given branches is ahead of and behind the given base branch,
+// bypassing the cache.
+func (self *BackendCommands) CommitCountsAgainstUncached(base gitdomain.LocalBranchName, branches gitdomain.LocalBranchNames) (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	major, minor, err := self.Version()
+	if err != nil {
+		return nil, err
+	}
+	if !(gitdomain.GitVersion{Major: major, Minor: minor}).IsAtLeast(2, 41) {
+		result := make(map[gitdomain.LocalBranchName]gitdomain.AheadBehind, len(branches))
+		for _, branch := range branches {
+			ahead, behind, err := self.CommitCounts(branch.BranchName(), base.BranchName())
+			if err != nil {
+				return result, err
+			}
+			result[branch] = gitdomain.AheadBehind{Ahead: ahead, Behind: behind}
+		}
+		return result, nil
+	}
+	args := []string{"for-each-ref", "--format=%(refname:lstrip=2) %(ahead-behind:" + base.String() + ")"}
+	for _, branch := range branches {
+		args = append(args, "refs/heads/"+branch.String())
+	}
+	output, err := self.Runner.QueryTrim("git", args...)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CommitCountsProblem, branches.Join(", "), base, err)
+	}
+	return ParseAheadBehindOutput(output)
+}
+
+// TrackingCounts provides how many commits the local branches are ahead of and behind their tracking branches,
+// using a single Git command.
+func (self *BackendCommands) TrackingCounts() (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	output, err := self.Runner.QueryTrim("git", "for-each-ref", "--format=%(refname:lstrip=2) %(upstream:track,nobracket)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	return ParseTrackingCountsOutput(output)
+}
+
+// Worktrees provides the worktrees of this repository that have a branch checked out.
+func (self *BackendCommands) Worktrees() (gitdomain.Worktrees, error) {
+	output, err := self.Runner.QueryTrim("git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return gitdomain.Worktrees{}, fmt.Errorf(messages.WorktreesProblem, err)
+	}
+	return ParseWorktreeListOutput(output), nil
+}
+
 func (self *BackendCommands) CommitsInBranch(branch, parent gitdomain.LocalBranchName) (gitdomain.Commits, error) {
 	if parent.IsEmpty() {
 		return self.CommitsInPerennialBranch()
@@ -281,6 +383,12 @@ func (self *BackendCommands) DefaultBranch() gitdomain.LocalBranchName {
 	return gitdomain.LocalBranchName(name)
 }
 
+// FetchesSharedLineage indicates whether "git fetch" downloads the shared lineage from origin.
+func (self *BackendCommands) FetchesSharedLineage() bool {
+	output, _ := self.Runner.Query("git", "config", "--get-all", "remote.origin.fetch")
+	return slices.Contains(stringslice.Lines(output), configdomain.SharedLineageRefspec)
+}
+
 func (self *BackendCommands) FirstExistingBranch(branches gitdomain.LocalBranchNames, mainBranch gitdomain.LocalBranchName) gitdomain.LocalBranchName {
 	for _, branch := range branches {
 		if self.BranchExists(branch) {
@@ -405,6 +513,26 @@ func (self *BackendCommands) SHAForBranch(name gitdomain.BranchName) (gitdomain.
 	return gitdomain.NewSHA(output), nil
 }
 
+// SharedLineage provides the lineage stored in the local shared lineage ref
+// and whether this ref exists.
+func (self *BackendCommands) SharedLineage() (configdomain.Lineage, bool) {
+	output, err := self.Runner.Query("git", "cat-file", "blob", configdomain.SharedLineageRef)
+	if err != nil {
+		return configdomain.Lineage{}, false
+	}
+	return configdomain.ParseSharedLineage(output), true
+}
+
+// SharedLineageSHA provides the SHA of the local shared lineage ref,
+// or an empty SHA if this ref doesn't exist.
+func (self *BackendCommands) SharedLineageSHA() gitdomain.SHA {
+	output, err := self.Runner.QueryTrim("git", "rev-parse", "--verify", "--quiet", configdomain.SharedLineageRef)
+	if err != nil {
+		return gitdomain.EmptySHA()
+	}
+	return gitdomain.NewSHA(output)
+}
+
 // ShouldPushBranch returns whether the local branch with the given name
 // contains commits that have not been pushed to its tracking branch.
 func (self *BackendCommands) ShouldPushBranch(branch gitdomain.LocalBranchName, trackingBranch gitdomain.RemoteBranchName) (bool, error) {
@@ -421,8 +549,21 @@ func (self *BackendCommands) StashSize() (gitdomain.StashSize, error) {
 	return gitdomain.StashSize(len(stringslice.Lines(output))), err
 }
 
-// Version indicates whether the needed Git version is installed.
+// Version provides the version of the installed Git executable.
 func (self *BackendCommands) Version() (major int, minor int, err error) {
+	if !self.VersionCache.Initialized() {
+		major, minor, err = self.VersionUncached()
+		if err != nil {
+			return major, minor, err
+		}
+		self.VersionCache.Set(gitdomain.GitVersion{Major: major, Minor: minor})
+	}
+	version := self.VersionCache.Value()
+	return version.Major, version.Minor, nil
+}
+
+// VersionUncached provides the version of the installed Git executable without using the cache.
+func (self *BackendCommands) VersionUncached() (major int, minor int, err error) {
 	versionRegexp := regexp.MustCompile(`git version (\d+).(\d+).(\d+)`)
 	output, err := self.Runner.QueryTrim("git", "version")
 	if err != nil {
@@ -443,6 +584,28 @@ func (self *BackendCommands) Version() (major int, minor int, err error) {
 	return majorVersion, minorVersion, nil
 }
 
+// WriteSharedLineage stores the given lineage in the local shared lineage ref.
+func (self *BackendCommands) WriteSharedLineage(lineage configdomain.Lineage) error {
+	file, err := os.CreateTemp("", "git-town-lineage-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(lineage.SerializeShared())
+	if err != nil {
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	sha, err := self.Runner.QueryTrim("git", "hash-object", "-w", file.Name())
+	if err != nil {
+		return err
+	}
+	return self.Runner.Run("git", "update-ref", configdomain.SharedLineageRef, sha)
+}
+
 func (self *BackendCommands) currentBranchDuringRebase() (gitdomain.LocalBranchName, error) {
 	output, err := self.Runner.QueryTrim("git", "branch", "--list")
 	if err != nil {
@@ -460,6 +623,63 @@ func (self *BackendCommands) currentBranchDuringRebase() (gitdomain.LocalBranchN
 	return ParseActiveBranchDuringRebase(lineWithStar), nil
 }
 
+// ParseAheadBehindOutput provides the commit counts in the given output of "git for-each-ref --format=%(refname:lstrip=2) %(ahead-behind:<base>)".
+func ParseAheadBehindOutput(output string) (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	result := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{}
+	for _, line := range stringslice.Lines(output) {
+		if line == "" {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 3 {
+			return result, fmt.Errorf(messages.AheadBehindUnexpectedOutput, line)
+		}
+		ahead, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return result, fmt.Errorf(messages.AheadBehindUnexpectedOutput, line)
+		}
+		behind, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return result, fmt.Errorf(messages.AheadBehindUnexpectedOutput, line)
+		}
+		result[gitdomain.NewLocalBranchName(parts[0])] = gitdomain.AheadBehind{Ahead: ahead, Behind: behind}
+	}
+	return result, nil
+}
+
+// ParseTrackingCountsOutput provides the commit counts in the given output of "git for-each-ref --format=%(refname:lstrip=2) %(upstream:track,nobracket)".
+// Branches that are in sync with their tracking branch or have no tracking branch are not part of the result.
+func ParseTrackingCountsOutput(output string) (map[gitdomain.LocalBranchName]gitdomain.AheadBehind, error) {
+	result := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{}
+	for _, line := range stringslice.Lines(output) {
+		branch, track, hasTrack := strings.Cut(line, " ")
+		if !hasTrack || track == "" || track == "gone" {
+			continue
+		}
+		counts := gitdomain.AheadBehind{Ahead: 0, Behind: 0}
+		for _, part := range strings.Split(track, ", ") {
+			direction, number, hasNumber := strings.Cut(part, " ")
+			if !hasNumber {
+				return result, fmt.Errorf(messages.TrackingCountsUnexpectedOutput, line)
+			}
+			count, err := strconv.Atoi(number)
+			if err != nil {
+				return result, fmt.Errorf(messages.TrackingCountsUnexpectedOutput, line)
+			}
+			switch direction {
+			case "ahead":
+				counts.Ahead = count
+			case "behind":
+				counts.Behind = count
+			default:
+				return result, fmt.Errorf(messages.TrackingCountsUnexpectedOutput, line)
+			}
+		}
+		result[gitdomain.NewLocalBranchName(branch)] = counts
+	}
+	return result, nil
+}
+
 func ParseActiveBranchDuringRebase(lineWithStar string) gitdomain.LocalBranchName {
 	parts := strings.Split(lineWithStar, " ")
 	partsWithBranchName := parts[4:]
@@ -535,6 +755,25 @@ func ParseVerboseBranchesOutput(output string) (gitdomain.BranchInfos, gitdomain
 	return result, checkedoutBranch
 }
 
+// ParseWorktreeListOutput provides the worktrees in the given output of "git worktree list --porcelain".
+// Worktrees that don't have a branch checked out are not part of the result.
+func ParseWorktreeListOutput(output string) gitdomain.Worktrees {
+	result := gitdomain.Worktrees{}
+	var dir string
+	for _, line := range stringslice.Lines(output) {
+		switch {
+		case strings.HasPrefix(line, "worktree "):
+			dir = strings.TrimPrefix(line, "worktree ")
+		case strings.HasPrefix(line, "branch refs/heads/"):
+			result = append(result, gitdomain.Worktree{
+				Branch: gitdomain.NewLocalBranchName(strings.TrimPrefix(line, "branch refs/heads/")),
+				Dir:    gitdomain.NewRepoRootDir(filepath.FromSlash(dir)),
+			})
+		}
+	}
+	return result
+}
+
 func determineSyncStatus(branchName, remoteText string) (syncStatus gitdomain.SyncStatus, trackingBranchName gitdomain.RemoteBranchName) {
 	isInSync, trackingBranchName := IsInSync(branchName, remoteText)
 	if isInSync {
@@ -1,6 +1,7 @@
 package git_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/git-town/git-town/v13/src/git"
@@ -97,6 +98,71 @@ func TestBackendCommands(t *testing.T) {
 		must.EqOp(t, initial, currentBranch)
 	})
 
+	t.Run("CommitCounts", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch := gitdomain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch,
+			FileName: "file1",
+			Message:  "commit 1",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch,
+			FileName: "file2",
+			Message:  "commit 2",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   initial,
+			FileName: "file3",
+			Message:  "commit 3",
+		})
+		ahead, behind, err := runtime.Backend.CommitCounts(branch.BranchName(), initial.BranchName())
+		must.NoError(t, err)
+		must.EqOp(t, 2, ahead)
+		must.EqOp(t, 1, behind)
+	})
+
+	t.Run("CommitCountsAgainst", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		branch1 := gitdomain.NewLocalBranchName("branch1")
+		branch2 := gitdomain.NewLocalBranchName("branch2")
+		runtime.CreateBranch(branch1, initial)
+		runtime.CreateBranch(branch2, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch1,
+			FileName: "file1",
+			Message:  "commit 1",
+		})
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   initial,
+			FileName: "file2",
+			Message:  "commit 2",
+		})
+		have, err := runtime.Backend.CommitCountsAgainst(initial, gitdomain.NewLocalBranchNames("branch1", "branch2"))
+		must.NoError(t, err)
+		want := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{
+			branch1: {Ahead: 1, Behind: 1},
+			branch2: {Ahead: 0, Behind: 1},
+		}
+		must.Eq(t, want, have)
+		// caches the results until the cache gets invalidated
+		runtime.CreateCommit(testgit.Commit{
+			Branch:   branch2,
+			FileName: "file3",
+			Message:  "commit 3",
+		})
+		have, err = runtime.Backend.CommitCountsAgainst(initial, gitdomain.NewLocalBranchNames("branch2"))
+		must.NoError(t, err)
+		must.Eq(t, map[gitdomain.LocalBranchName]gitdomain.AheadBehind{branch2: {Ahead: 0, Behind: 1}}, have)
+		runtime.Backend.CommitCountsCache.Invalidate()
+		have, err = runtime.Backend.CommitCountsAgainst(initial, gitdomain.NewLocalBranchNames("branch2"))
+		must.NoError(t, err)
+		must.Eq(t, map[gitdomain.LocalBranchName]gitdomain.AheadBehind{branch2: {Ahead: 1, Behind: 1}}, have)
+	})
+
 	t.Run("CommitsInBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("feature branch contains commits", func(t *testing.T) {
@@ -394,6 +460,76 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("ParseAheadBehindOutput", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid output", func(t *testing.T) {
+			t.Parallel()
+			give := "branch-1 2 0\nbranch-2 0 3\n"
+			have, err := git.ParseAheadBehindOutput(give)
+			must.NoError(t, err)
+			want := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{
+				gitdomain.NewLocalBranchName("branch-1"): {Ahead: 2, Behind: 0},
+				gitdomain.NewLocalBranchName("branch-2"): {Ahead: 0, Behind: 3},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("unexpected output", func(t *testing.T) {
+			t.Parallel()
+			_, err := git.ParseAheadBehindOutput("branch-1 2")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("ParseTrackingCountsOutput", func(t *testing.T) {
+		t.Parallel()
+		t.Run("valid output", func(t *testing.T) {
+			t.Parallel()
+			give := `
+main
+ahead ahead 1
+behind behind 2
+both ahead 3, behind 4
+deleted gone
+`[1:]
+			have, err := git.ParseTrackingCountsOutput(give)
+			must.NoError(t, err)
+			want := map[gitdomain.LocalBranchName]gitdomain.AheadBehind{
+				gitdomain.NewLocalBranchName("ahead"):  {Ahead: 1, Behind: 0},
+				gitdomain.NewLocalBranchName("behind"): {Ahead: 0, Behind: 2},
+				gitdomain.NewLocalBranchName("both"):   {Ahead: 3, Behind: 4},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("unexpected output", func(t *testing.T) {
+			t.Parallel()
+			_, err := git.ParseTrackingCountsOutput("branch sideways 1")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("ParseWorktreeListOutput", func(t *testing.T) {
+		t.Parallel()
+		give := `
+worktree /repo
+HEAD 111111
+branch refs/heads/main
+
+worktree /repo-feature
+HEAD 222222
+branch refs/heads/kg/feature
+
+worktree /repo-detached
+HEAD 333333
+detached
+`[1:]
+		have := git.ParseWorktreeListOutput(give)
+		want := gitdomain.Worktrees{
+			{Branch: gitdomain.NewLocalBranchName("main"), Dir: gitdomain.NewRepoRootDir(filepath.FromSlash("/repo"))},
+			{Branch: gitdomain.NewLocalBranchName("kg/feature"), Dir: gitdomain.NewRepoRootDir(filepath.FromSlash("/repo-feature"))},
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("ParseVerboseBranchesOutput", func(t *testing.T) {
 		t.Parallel()
 		t.Run("recognizes the current branch", func(t *testing.T) {
@@ -756,6 +892,7 @@ func TestBackendCommands(t *testing.T) {
 			dir := t.TempDir()
 			runner := subshell.BackendRunner{
 				Dir:             &dir,
+				Output:          nil,
 				Verbose:         false,
 				CommandsCounter: &gohacks.Counter{},
 			}
@@ -763,8 +900,10 @@ func TestBackendCommands(t *testing.T) {
 				Runner:             runner,
 				DryRun:             false,
 				Config:             nil,
+				CommitCountsCache:  &cache.CommitCounts{},
 				CurrentBranchCache: &cache.LocalBranchWithPrevious{},
 				RemotesCache:       &cache.Remotes{},
+				VersionCache:       &cache.GitVersion{},
 			}
 			have := cmds.RootDirectory()
 			want := gitdomain.EmptyRepoRootDir()
@@ -35,6 +35,11 @@ func (self *FrontendCommands) AbortRebase() error {
 	return self.Runner.Run("git", "rebase", "--abort")
 }
 
+// AddSharedLineageRefspec configures "git fetch" to download the shared lineage from origin.
+func (self *FrontendCommands) AddSharedLineageRefspec() error {
+	return self.Runner.Run("git", "config", "--add", "remote.origin.fetch", configdomain.SharedLineageRefspec)
+}
+
 // CheckoutBranch checks out the Git branch with the given name in this repo.
 func (self *FrontendCommands) CheckoutBranch(name gitdomain.LocalBranchName) error {
 	err := self.Runner.Run("git", "checkout", name.String())
@@ -82,6 +87,12 @@ func (self *FrontendCommands) CreateBranch(name gitdomain.LocalBranchName, paren
 	return self.Runner.Run("git", "branch", name.String(), parent.String())
 }
 
+// CreateWorktree creates a new branch with the given name off the given starting point
+// and checks it out in a new worktree at the given directory.
+func (self *FrontendCommands) CreateWorktree(dir string, branch gitdomain.LocalBranchName, startingPoint gitdomain.Location) error {
+	return self.Runner.Run("git", "worktree", "add", "-b", branch.String(), dir, startingPoint.String())
+}
+
 // CreateRemoteBranch creates a remote branch from the given local SHA.
 func (self *FrontendCommands) CreateRemoteBranch(localSHA gitdomain.SHA, branch gitdomain.LocalBranchName, noPushHook configdomain.NoPushHook) error {
 	args := []string{"push"}
@@ -144,6 +155,16 @@ func (self *FrontendCommands) Fetch() error {
 	return self.Runner.Run("git", "fetch", "--prune", "--tags")
 }
 
+// FetchRemotes retrieves the updates from all given remotes.
+// Git fetches from the remotes concurrently.
+func (self *FrontendCommands) FetchRemotes(remotes gitdomain.Remotes) error {
+	args := []string{"fetch", "--prune", "--tags", "--multiple", fmt.Sprintf("--jobs=%d", len(remotes))}
+	for _, remote := range remotes {
+		args = append(args, remote.String())
+	}
+	return self.Runner.Run("git", args...)
+}
+
 // FetchUpstream fetches updates from the upstream remote.
 func (self *FrontendCommands) FetchUpstream(branch gitdomain.LocalBranchName) error {
 	return self.Runner.Run("git", "fetch", gitdomain.RemoteUpstream.String(), branch.String())
@@ -188,6 +209,14 @@ func (self *FrontendCommands) PushCurrentBranch(noPushHook configdomain.NoPushHo
 	return self.Runner.Run("git", args...)
 }
 
+// PushSharedLineage uploads the local shared lineage to origin
+// if the shared lineage at origin still has the given SHA, i.e. nobody has pushed it since it was fetched.
+// An empty SHA expects that origin contains no shared lineage.
+func (self *FrontendCommands) PushSharedLineage(fetchedSHA gitdomain.SHA) error {
+	lease := fmt.Sprintf("--force-with-lease=%s:%s", configdomain.SharedLineageRef, fetchedSHA)
+	return self.Runner.Run("git", "push", lease, gitdomain.RemoteOrigin.String(), configdomain.SharedLineageRef)
+}
+
 // PushTags pushes new the Git tags to origin.
 func (self *FrontendCommands) PushTags() error {
 	return self.Runner.Run("git", "push", "--tags")
@@ -198,8 +227,19 @@ func (self *FrontendCommands) Rebase(target gitdomain.BranchName) error {
 	return self.Runner.Run("git", "rebase", target.String())
 }
 
+// RebaseOnto rebases the current branch onto the given branch,
+// removing all commits that are also in the given upstream location.
+func (self *FrontendCommands) RebaseOnto(onto gitdomain.BranchName, upstream gitdomain.Location) error {
+	return self.Runner.Run("git", "rebase", "--onto", onto.String(), upstream.String())
+}
+
+// RemoveWorktree removes the worktree at the given directory.
+func (self *FrontendCommands) RemoveWorktree(dir gitdomain.RepoRootDir) error {
+	return self.Runner.Run("git", "worktree", "remove", dir.String())
+}
+
 // ResetCurrentBranchToSHA undoes all commits on the current branch all the way until the given SHA.
-func (self *FrontendCommands) RemoveCommitsInCurrentBranch(parent gitdomain.LocalBranchName) error {
+func (self *FrontendCommands) RemoveCommitsInCurrentBranch(parent gitdomain.BranchName) error {
 	return self.Runner.Run("git", "reset", "--soft", parent.String())
 }
 
@@ -234,6 +274,21 @@ func (self *FrontendCommands) SetGitAlias(aliasableCommand configdomain.Aliasabl
 	return self.Runner.Run("git", "config", "--global", gitconfig.KeyForAliasableCommand(aliasableCommand).String(), "town "+aliasableCommand.String())
 }
 
+// SetAzureDevOpsToken sets the given personal access token for the Azure DevOps API.
+func (self *FrontendCommands) SetAzureDevOpsToken(value configdomain.AzureDevOpsToken) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyAzureDevOpsToken.String(), value.String())
+}
+
+// SetBitbucketAppPassword sets the given app password for the Bitbucket API.
+func (self *FrontendCommands) SetBitbucketAppPassword(value configdomain.BitbucketAppPassword) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyBitbucketAppPassword.String(), value.String())
+}
+
+// SetBitbucketUsername sets the given username for the Bitbucket API.
+func (self *FrontendCommands) SetBitbucketUsername(value configdomain.BitbucketUsername) error {
+	return self.Runner.Run("git", "config", gitconfig.KeyBitbucketUsername.String(), value.String())
+}
+
 // SetGitHubToken sets the given API token for the GitHub API.
 func (self *FrontendCommands) SetGitHubToken(value configdomain.GitHubToken) error {
 	return self.Runner.Run("git", "config", "git-town.github-token", value.String())
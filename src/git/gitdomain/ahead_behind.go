@@ -0,0 +1,7 @@
+package gitdomain
+
+// AheadBehind describes how many commits a branch has that another branch doesn't have, and vice versa.
+type AheadBehind struct {
+	Ahead  int // how many commits the branch has that the other branch doesn't have
+	Behind int // how many commits the other branch has that the branch doesn't have
+}
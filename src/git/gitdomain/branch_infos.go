@@ -91,6 +91,17 @@ func (self BranchInfos) LocalBranchesWithDeletedTrackingBranches() BranchInfos {
 	return result
 }
 
+// OtherWorktreeBranches provides the names of the branches that are active in another worktree.
+func (self BranchInfos) OtherWorktreeBranches() LocalBranchNames {
+	result := LocalBranchNames{}
+	for _, bi := range self {
+		if bi.SyncStatus == SyncStatusOtherWorktree {
+			result = append(result, bi.LocalName)
+		}
+	}
+	return result
+}
+
 // Names provides the names of all local branches in this BranchesSyncStatus instance.
 func (self BranchInfos) Names() LocalBranchNames {
 	result := make(LocalBranchNames, 0, len(self))
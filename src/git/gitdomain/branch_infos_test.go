@@ -246,6 +246,29 @@ func TestBranchInfos(t *testing.T) {
 		must.Eq(t, want, have)
 	})
 
+	t.Run("OtherWorktreeBranches", func(t *testing.T) {
+		t.Parallel()
+		bs := gitdomain.BranchInfos{
+			gitdomain.BranchInfo{
+				LocalName:  gitdomain.NewLocalBranchName("up-to-date"),
+				LocalSHA:   gitdomain.NewSHA("111111"),
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+				RemoteName: gitdomain.NewRemoteBranchName("origin/up-to-date"),
+				RemoteSHA:  gitdomain.NewSHA("111111"),
+			},
+			gitdomain.BranchInfo{
+				LocalName:  gitdomain.NewLocalBranchName("other-worktree"),
+				LocalSHA:   gitdomain.NewSHA("222222"),
+				SyncStatus: gitdomain.SyncStatusOtherWorktree,
+				RemoteName: gitdomain.NewRemoteBranchName("origin/other-worktree"),
+				RemoteSHA:  gitdomain.EmptySHA(),
+			},
+		}
+		have := bs.OtherWorktreeBranches()
+		want := gitdomain.NewLocalBranchNames("other-worktree")
+		must.Eq(t, want, have)
+	})
+
 	t.Run("LookupLocalBranch", func(t *testing.T) {
 		t.Parallel()
 		t.Run("local branch with matching name", func(t *testing.T) {
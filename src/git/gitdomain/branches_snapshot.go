@@ -21,3 +21,19 @@ func EmptyBranchesSnapshot() BranchesSnapshot {
 func (self BranchesSnapshot) IsEmpty() bool {
 	return len(self.Branches) == 0 && self.Active.IsEmpty()
 }
+
+// HasSameBranches indicates whether the given snapshot contains the same local and tracking branches
+// pointing to the same commits as this snapshot.
+// The checked out branch doesn't matter.
+func (self BranchesSnapshot) HasSameBranches(other BranchesSnapshot) bool {
+	if len(self.Branches) != len(other.Branches) {
+		return false
+	}
+	for _, branch := range self.Branches {
+		otherBranch := other.Branches.FindMatchingRecord(branch)
+		if otherBranch.LocalName != branch.LocalName || otherBranch.LocalSHA != branch.LocalSHA || otherBranch.RemoteName != branch.RemoteName || otherBranch.RemoteSHA != branch.RemoteSHA {
+			return false
+		}
+	}
+	return true
+}
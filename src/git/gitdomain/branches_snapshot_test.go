@@ -0,0 +1,68 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchesSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("HasSameBranches", func(t *testing.T) {
+		t.Parallel()
+		branch1 := gitdomain.BranchInfo{
+			LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+			LocalSHA:   gitdomain.NewSHA("111111"),
+			RemoteName: gitdomain.NewRemoteBranchName("origin/branch-1"),
+			RemoteSHA:  gitdomain.NewSHA("111111"),
+			SyncStatus: gitdomain.SyncStatusUpToDate,
+		}
+		branch2 := gitdomain.BranchInfo{
+			LocalName:  gitdomain.NewLocalBranchName("branch-2"),
+			LocalSHA:   gitdomain.NewSHA("222222"),
+			RemoteName: gitdomain.EmptyRemoteBranchName(),
+			RemoteSHA:  gitdomain.EmptySHA(),
+			SyncStatus: gitdomain.SyncStatusLocalOnly,
+		}
+		t.Run("same branches in different order and different active branch", func(t *testing.T) {
+			t.Parallel()
+			snapshot1 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-1"),
+				Branches: gitdomain.BranchInfos{branch1, branch2},
+			}
+			snapshot2 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-2"),
+				Branches: gitdomain.BranchInfos{branch2, branch1},
+			}
+			must.True(t, snapshot1.HasSameBranches(snapshot2))
+		})
+		t.Run("branch at a different commit", func(t *testing.T) {
+			t.Parallel()
+			changed := branch2
+			changed.LocalSHA = gitdomain.NewSHA("333333")
+			snapshot1 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-1"),
+				Branches: gitdomain.BranchInfos{branch1, branch2},
+			}
+			snapshot2 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-1"),
+				Branches: gitdomain.BranchInfos{branch1, changed},
+			}
+			must.False(t, snapshot1.HasSameBranches(snapshot2))
+		})
+		t.Run("additional branch", func(t *testing.T) {
+			t.Parallel()
+			snapshot1 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-1"),
+				Branches: gitdomain.BranchInfos{branch1},
+			}
+			snapshot2 := gitdomain.BranchesSnapshot{
+				Active:   gitdomain.NewLocalBranchName("branch-1"),
+				Branches: gitdomain.BranchInfos{branch1, branch2},
+			}
+			must.False(t, snapshot1.HasSameBranches(snapshot2))
+		})
+	})
+}
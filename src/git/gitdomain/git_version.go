@@ -0,0 +1,12 @@
+package gitdomain
+
+// GitVersion describes the version of the installed Git executable.
+type GitVersion struct {
+	Major int
+	Minor int
+}
+
+// IsAtLeast indicates whether this version is the given version or newer.
+func (self GitVersion) IsAtLeast(major, minor int) bool {
+	return self.Major > major || (self.Major == major && self.Minor >= minor)
+}
@@ -0,0 +1,22 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestGitVersion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsAtLeast", func(t *testing.T) {
+		t.Parallel()
+		version := gitdomain.GitVersion{Major: 2, Minor: 41}
+		must.True(t, version.IsAtLeast(2, 41))
+		must.True(t, version.IsAtLeast(2, 30))
+		must.True(t, version.IsAtLeast(1, 50))
+		must.False(t, version.IsAtLeast(2, 42))
+		must.False(t, version.IsAtLeast(3, 0))
+	})
+}
@@ -0,0 +1,20 @@
+package gitdomain
+
+// Worktree describes a Git worktree that has a branch checked out.
+type Worktree struct {
+	Branch LocalBranchName // the branch checked out in this worktree
+	Dir    RepoRootDir     // the root directory of this worktree
+}
+
+// Worktrees is a collection of Worktree instances.
+type Worktrees []Worktree
+
+// FindByBranch provides the worktree that has the given branch checked out.
+func (self Worktrees) FindByBranch(branch LocalBranchName) *Worktree {
+	for w, worktree := range self {
+		if worktree.Branch == branch {
+			return &self[w]
+		}
+	}
+	return nil
+}
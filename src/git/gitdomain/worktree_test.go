@@ -0,0 +1,31 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestWorktrees(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FindByBranch", func(t *testing.T) {
+		t.Parallel()
+		worktrees := gitdomain.Worktrees{
+			{Branch: gitdomain.NewLocalBranchName("main"), Dir: gitdomain.NewRepoRootDir("/repo")},
+			{Branch: gitdomain.NewLocalBranchName("feature"), Dir: gitdomain.NewRepoRootDir("/feature")},
+		}
+		t.Run("contains the branch", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.FindByBranch(gitdomain.NewLocalBranchName("feature"))
+			must.NotNil(t, have)
+			must.EqOp(t, gitdomain.NewRepoRootDir("/feature"), have.Dir)
+		})
+		t.Run("does not contain the branch", func(t *testing.T) {
+			t.Parallel()
+			have := worktrees.FindByBranch(gitdomain.NewLocalBranchName("other"))
+			must.Nil(t, have)
+		})
+	})
+}
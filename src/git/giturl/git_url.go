@@ -15,6 +15,9 @@ type Parts struct {
 
 func Parse(url string) *Parts {
 	patterns := []string{
+		// Azure DevOps URLs contain an additional project segment and a fixed "_git" or "v3" segment
+		`^https://(?P<user>.*@)?(?P<host>dev\.azure\.com/)(?P<org>[^/]+/[^/]+/)_git/(?P<repo>[^/]+)$`,
+		`^(?P<user>.*@)?(?P<host>ssh\.dev\.azure\.com:)v3/(?P<org>[^/]+/[^/]+/)(?P<repo>[^/]+)$`,
 		`^[^:]+://(?P<user>.*@)?(?P<host>.*\/)(?P<org>.*\/)(?P<repo>.*)\.git$`,
 		`^[^:]+://(?P<user>.*@)?(?P<host>.*\/)(?P<org>.*\/)(?P<repo>.*)$`,
 		`^(?P<user>.*@)?(?P<host>.*?[:/])(?P<org>.*\/)(?P<repo>.*)\.git$`,
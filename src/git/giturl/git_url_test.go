@@ -10,6 +10,9 @@ import (
 func TestParse(t *testing.T) {
 	t.Parallel()
 	tests := map[string]giturl.Parts{
+		"https://dev.azure.com/org/project/_git/repo":          {User: "", Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
+		"https://org@dev.azure.com/org/project/_git/repo":      {User: "org", Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
+		"git@ssh.dev.azure.com:v3/org/project/repo":            {User: "git", Host: "ssh.dev.azure.com", Org: "org/project", Repo: "repo"},
 		"git@github.com:git-town/git-town.git":                 {User: "git", Host: "github.com", Org: "git-town", Repo: "git-town"},
 		"git@bitbucket.org/git-town/git-town.git":              {User: "git", Host: "bitbucket.org", Org: "git-town", Repo: "git-town"},
 		"git@bitbucket.org/git-town/git-town.github.com":       {User: "git", Host: "bitbucket.org", Org: "git-town", Repo: "git-town.github.com"},
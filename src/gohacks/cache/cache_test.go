@@ -3,6 +3,7 @@ package cache_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/gohacks/cache"
 	"github.com/shoenig/test/must"
 )
@@ -33,3 +34,19 @@ func TestStringSliceCache(t *testing.T) {
 	must.True(t, ssc.Initialized())
 	must.Eq(t, []string{"foo"}, ssc.Value())
 }
+
+func TestCommitCountsCache(t *testing.T) {
+	t.Parallel()
+	ccc := cache.CommitCounts{}
+	_, has := ccc.Get("main", "feature")
+	must.False(t, has)
+	ccc.Set("main", "feature", gitdomain.AheadBehind{Ahead: 2, Behind: 1})
+	have, has := ccc.Get("main", "feature")
+	must.True(t, has)
+	must.EqOp(t, gitdomain.AheadBehind{Ahead: 2, Behind: 1}, have)
+	_, has = ccc.Get("feature", "main")
+	must.False(t, has)
+	ccc.Invalidate()
+	_, has = ccc.Get("main", "feature")
+	must.False(t, has)
+}
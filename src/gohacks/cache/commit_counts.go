@@ -0,0 +1,32 @@
+package cache
+
+import "github.com/git-town/git-town/v13/src/git/gitdomain"
+
+// CommitCounts caches how many commits branches are ahead of and behind base branches.
+// The zero value is an empty cache.
+type CommitCounts struct {
+	values map[gitdomain.LocalBranchName]map[gitdomain.LocalBranchName]gitdomain.AheadBehind // base branch --> branch --> commit counts
+}
+
+// Get provides the cached commit counts of the given branch against the given base branch
+// and whether they exist in this cache.
+func (c *CommitCounts) Get(base, branch gitdomain.LocalBranchName) (gitdomain.AheadBehind, bool) {
+	counts, has := c.values[base][branch]
+	return counts, has
+}
+
+// Invalidate removes all cached values.
+func (c *CommitCounts) Invalidate() {
+	c.values = nil
+}
+
+// Set stores the commit counts of the given branch against the given base branch.
+func (c *CommitCounts) Set(base, branch gitdomain.LocalBranchName, counts gitdomain.AheadBehind) {
+	if c.values == nil {
+		c.values = map[gitdomain.LocalBranchName]map[gitdomain.LocalBranchName]gitdomain.AheadBehind{}
+	}
+	if c.values[base] == nil {
+		c.values[base] = map[gitdomain.LocalBranchName]gitdomain.AheadBehind{}
+	}
+	c.values[base][branch] = counts
+}
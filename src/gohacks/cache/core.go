@@ -6,6 +6,9 @@ import "github.com/git-town/git-town/v13/src/git/gitdomain"
 // Bool is a cache for bool variables.
 type Bool = Cache[bool]
 
+// GitVersion is a cache for gitdomain.GitVersion variables.
+type GitVersion = Cache[gitdomain.GitVersion]
+
 // LocalBranch is a cache for gitdomain.LocalBranchName variables.
 type LocalBranchWithPrevious = WithPrevious[gitdomain.LocalBranchName]
 
@@ -1,14 +1,17 @@
 package gohacks
 
+import "sync/atomic"
+
 // Counter is a Statistics implementation that counts how many commands were run.
+// It is safe for concurrent use.
 type Counter struct {
-	count int
+	count atomic.Int64
 }
 
 func (self *Counter) Count() int {
-	return self.count
+	return int(self.count.Load())
 }
 
 func (self *Counter) Register() {
-	self.count++
+	self.count.Add(1)
 }
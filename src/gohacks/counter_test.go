@@ -1,6 +1,7 @@
 package gohacks_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/git-town/git-town/v13/src/gohacks"
@@ -9,8 +10,27 @@ import (
 
 func TestCounter(t *testing.T) {
 	t.Parallel()
-	counter := gohacks.Counter{}
-	counter.Register()
-	counter.Register()
-	must.Eq(t, 2, counter.Count())
+
+	t.Run("sequential use", func(t *testing.T) {
+		t.Parallel()
+		counter := gohacks.Counter{}
+		counter.Register()
+		counter.Register()
+		must.Eq(t, 2, counter.Count())
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		t.Parallel()
+		counter := gohacks.Counter{}
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				counter.Register()
+				wg.Done()
+			}()
+		}
+		wg.Wait()
+		must.Eq(t, 10, counter.Count())
+	})
 }
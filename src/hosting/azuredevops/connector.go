@@ -0,0 +1,346 @@
+package azuredevops
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+const (
+	// DefaultAPIURL is the base URL of the Azure DevOps REST API.
+	DefaultAPIURL = "https://dev.azure.com"
+
+	// the version of the Azure DevOps REST API that this connector talks to
+	apiVersion = "7.0"
+
+	// prefix of the fully qualified branch names used by the Azure DevOps API
+	branchRefPrefix = "refs/heads/"
+)
+
+// Connector provides access to the API of Azure DevOps.
+type Connector struct {
+	hostingdomain.Config
+	APIToken configdomain.AzureDevOpsToken
+	APIURL   string
+	Project  string
+	client   *http.Client
+	log      print.Logger
+}
+
+// NewConnector provides an Azure DevOps connector instance if the current repo is hosted on Azure DevOps,
+// otherwise nil.
+func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	organization, project, _ := strings.Cut(args.OriginURL.Org, "/")
+	return &Connector{
+		APIToken: args.APIToken,
+		APIURL:   DefaultAPIURL,
+		Config: hostingdomain.Config{
+			Hostname:     webHostname(args.OriginURL.Host),
+			Organization: organization,
+			Repository:   args.OriginURL.Repo,
+		},
+		Project: project,
+		client:  http.DefaultClient,
+		log:     args.Log,
+	}, nil
+}
+
+type NewConnectorArgs struct {
+	APIToken        configdomain.AzureDevOpsToken
+	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
+	OriginURL       *giturl.Parts
+}
+
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingAzureDevOpsCreatePRViaAPI, branch, parentBranch)
+	var created pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsURL(), nil, createRequest{
+		Description:   body,
+		IsDraft:       draft,
+		SourceRefName: branchRefPrefix + branch.String(),
+		TargetRefName: branchRefPrefix + parentBranch.String(),
+		Title:         title,
+	}, &created)
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := self.parsePullRequest(created)
+	return &proposal, nil
+}
+
+func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("Merged PR %d: %s", proposal.Number, proposal.Title)
+}
+
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	if self.APIToken == "" {
+		return nil, nil //nolint:nilnil
+	}
+	query := url.Values{}
+	query.Set("searchCriteria.sourceRefName", branchRefPrefix+branch.String())
+	if !target.IsEmpty() {
+		query.Set("searchCriteria.targetRefName", branchRefPrefix+target.String())
+	}
+	query.Set("searchCriteria.status", "active")
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsURL(), query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Value) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if len(response.Value) > 1 {
+		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(response.Value), branch, target)
+	}
+	proposal := self.parsePullRequest(response.Value[0])
+	return &proposal, nil
+}
+
+func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	query := url.Values{}
+	query.Set("sourceRef", branch.String())
+	query.Set("targetRef", parentBranch.String())
+	return fmt.Sprintf("%s/pullrequestcreate?%s", self.RepositoryURL(), query.Encode()), nil
+}
+
+func (self *Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/%s/%s/_git/%s", self.HostnameWithStandardPort(), self.Organization, self.Project, self.Repository)
+}
+
+func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsMergingViaAPI, number)
+	pullRequestURL := self.pullRequestURL(number)
+	// Azure DevOps completes a pull request only if we confirm the commit it has last seen
+	var existing pullRequest
+	err := self.request(http.MethodGet, pullRequestURL, nil, nil, &existing)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPatch, pullRequestURL, nil, completeRequest{
+		CompletionOptions: completionOptions{
+			DeleteSourceBranch: false,
+			MergeCommitMessage: message.String(),
+			MergeStrategy:      "squash",
+		},
+		LastMergeSourceCommit: existing.LastMergeSourceCommit,
+		Status:                "completed",
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingAzureDevOpsUpdateBodyViaAPI, number)
+	err := self.request(http.MethodPatch, self.pullRequestURL(number), nil, describeRequest{
+		Description: body,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingAzureDevOpsUpdatePRViaAPI, number, target)
+	err := self.request(http.MethodPatch, self.pullRequestURL(number), nil, retargetRequest{
+		TargetRefName: branchRefPrefix + target.String(),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// parsePullRequest extracts standardized proposal data from the given Azure DevOps pull request.
+func (self *Connector) parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Author:         pullRequest.CreatedBy.UniqueName,
+		Body:           pullRequest.Description,
+		Checks:         hostingdomain.ChecksStatusNone,
+		Draft:          pullRequest.IsDraft,
+		MergeWithAPI:   pullRequest.MergeStatus == "succeeded",
+		Number:         pullRequest.PullRequestID,
+		ReviewDecision: parseReviewers(pullRequest.Reviewers),
+		Target:         gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.TargetRefName, branchRefPrefix)),
+		Title:          pullRequest.Title,
+		URL:            fmt.Sprintf("%s/pullrequest/%d", self.RepositoryURL(), pullRequest.PullRequestID),
+	}
+}
+
+func (self *Connector) pullRequestURL(number int) string {
+	return fmt.Sprintf("%s/%d", self.pullRequestsURL(), number)
+}
+
+func (self *Connector) pullRequestsURL() string {
+	return fmt.Sprintf("%s/%s/%s/_apis/git/repositories/%s/pullrequests", self.APIURL, url.PathEscape(self.Organization), url.PathEscape(self.Project), url.PathEscape(self.Repository))
+}
+
+// request sends the given payload as JSON to the given Azure DevOps API endpoint
+// and decodes the JSON response into the given result if it isn't nil.
+func (self *Connector) request(method, endpoint string, query url.Values, payload, result any) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, endpoint+"?"+query.Encode(), body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	// personal access tokens go into the password part of basic authentication
+	request.SetBasicAuth("", self.APIToken.String())
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingAzureDevOpsAPIProblem, method, endpoint, response.Status, parseErrorMessage(response.Body))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+type commitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+type completeRequest struct {
+	CompletionOptions     completionOptions `json:"completionOptions"`
+	LastMergeSourceCommit commitRef         `json:"lastMergeSourceCommit"`
+	Status                string            `json:"status"`
+}
+
+type completionOptions struct {
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	MergeCommitMessage string `json:"mergeCommitMessage"`
+	MergeStrategy      string `json:"mergeStrategy"`
+}
+
+type createRequest struct {
+	Description   string `json:"description"`
+	IsDraft       bool   `json:"isDraft"`
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+}
+
+type describeRequest struct {
+	Description string `json:"description"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+type identity struct {
+	UniqueName string `json:"uniqueName"`
+}
+
+type pullRequest struct {
+	CreatedBy             identity   `json:"createdBy"`
+	Description           string     `json:"description"`
+	IsDraft               bool       `json:"isDraft"`
+	LastMergeSourceCommit commitRef  `json:"lastMergeSourceCommit"`
+	MergeStatus           string     `json:"mergeStatus"`
+	PullRequestID         int        `json:"pullRequestId"`
+	Reviewers             []reviewer `json:"reviewers"`
+	TargetRefName         string     `json:"targetRefName"`
+	Title                 string     `json:"title"`
+}
+
+type pullRequestList struct {
+	Value []pullRequest `json:"value"`
+}
+
+type retargetRequest struct {
+	TargetRefName string `json:"targetRefName"`
+}
+
+type reviewer struct {
+	IsRequired bool `json:"isRequired"`
+	// 10 = approved, 5 = approved with suggestions, 0 = no vote, -5 = waiting for author, -10 = rejected
+	Vote int `json:"vote"`
+}
+
+// parseErrorMessage extracts the human-readable error message from the given Azure DevOps API error response.
+func parseErrorMessage(body io.Reader) string {
+	var response errorResponse
+	err := json.NewDecoder(body).Decode(&response)
+	if err != nil {
+		return ""
+	}
+	return response.Message
+}
+
+// parseReviewers determines the review decision from the votes of the given Azure DevOps reviewers.
+func parseReviewers(reviewers []reviewer) hostingdomain.ReviewDecision {
+	approved := false
+	missingRequiredVote := false
+	for _, reviewer := range reviewers {
+		switch {
+		case reviewer.Vote < 0:
+			return hostingdomain.ReviewDecisionChangesRequested
+		case reviewer.Vote > 0:
+			approved = true
+		case reviewer.IsRequired:
+			missingRequiredVote = true
+		}
+	}
+	switch {
+	case missingRequiredVote:
+		return hostingdomain.ReviewDecisionReviewRequired
+	case approved:
+		return hostingdomain.ReviewDecisionApproved
+	}
+	return hostingdomain.ReviewDecisionNone
+}
+
+// webHostname provides the hostname of the web interface for the given Git server hostname.
+func webHostname(gitHostname string) string {
+	if gitHostname == "ssh.dev.azure.com" {
+		return "dev.azure.com"
+	}
+	return gitHostname
+}
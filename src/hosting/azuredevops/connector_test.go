@@ -0,0 +1,330 @@
+package azuredevops_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/azuredevops"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestAzureDevOpsConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("HTTPS remote", func(t *testing.T) {
+			t.Parallel()
+			have := newTestConnector(t, "https://dev.azure.com/org/project/_git/repo", "")
+			wantConfig := hostingdomain.Config{
+				Hostname:     "dev.azure.com",
+				Organization: "org",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, "project", have.Project)
+		})
+
+		t.Run("SSH remote", func(t *testing.T) {
+			t.Parallel()
+			have := newTestConnector(t, "git@ssh.dev.azure.com:v3/org/project/repo", "")
+			wantConfig := hostingdomain.Config{
+				Hostname:     "dev.azure.com",
+				Organization: "org",
+				Repository:   "repo",
+			}
+			must.EqOp(t, wantConfig, have.Config)
+			must.EqOp(t, "project", have.Project)
+		})
+	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, http.MethodPost, r.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests", r.URL.Path)
+			var body map[string]any
+			must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			must.EqOp(t, "refs/heads/feature", body["sourceRefName"].(string))
+			must.EqOp(t, "refs/heads/main", body["targetRefName"].(string))
+			must.EqOp(t, "my title", body["title"].(string))
+			must.EqOp(t, "my description", body["description"].(string))
+			must.False(t, body["isDraft"].(bool))
+			_, _ = w.Write([]byte(`{"pullRequestId": 12, "title": "my title", "targetRefName": "refs/heads/main"}`))
+		}))
+		defer server.Close()
+		connector := newAPITestConnector(t, server.URL)
+		have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"), "my title", "my description", false)
+		must.NoError(t, err)
+		must.EqOp(t, 12, have.Number)
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo/pullrequest/12", have.URL)
+	})
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "https://dev.azure.com/org/project/_git/repo", "")
+		give := hostingdomain.Proposal{
+			MergeWithAPI: true,
+			Number:       12,
+			Target:       gitdomain.NewLocalBranchName("main"),
+			Title:        "my title",
+		}
+		must.EqOp(t, "Merged PR 12: my title", connector.DefaultProposalMessage(give))
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "git@ssh.dev.azure.com:v3/org/project/repo", "")
+		have, err := connector.NewProposalURL(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+		must.NoError(t, err)
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo/pullrequestcreate?sourceRef=feature&targetRef=main", have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "git@ssh.dev.azure.com:v3/org/project/repo", "")
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo", connector.RepositoryURL())
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("single active pull request", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests", r.URL.Path)
+				must.EqOp(t, "refs/heads/feature", r.URL.Query().Get("searchCriteria.sourceRefName"))
+				must.EqOp(t, "refs/heads/main", r.URL.Query().Get("searchCriteria.targetRefName"))
+				must.EqOp(t, "active", r.URL.Query().Get("searchCriteria.status"))
+				must.EqOp(t, "7.0", r.URL.Query().Get("api-version"))
+				_, password, ok := r.BasicAuth()
+				must.True(t, ok)
+				must.EqOp(t, "secret", password)
+				_, _ = w.Write([]byte(`{"count": 1, "value": [{
+					"pullRequestId": 12,
+					"title": "my title",
+					"description": "my description",
+					"createdBy": {"uniqueName": "alice@example.com"},
+					"isDraft": false,
+					"targetRefName": "refs/heads/main",
+					"mergeStatus": "succeeded",
+					"reviewers": [{"vote": 10}, {"vote": 0}]
+				}]}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			want := hostingdomain.Proposal{
+				Author:         "alice@example.com",
+				Body:           "my description",
+				Checks:         hostingdomain.ChecksStatusNone,
+				Draft:          false,
+				MergeWithAPI:   true,
+				Number:         12,
+				ReviewDecision: hostingdomain.ReviewDecisionApproved,
+				Target:         gitdomain.NewLocalBranchName("main"),
+				Title:          "my title",
+				URL:            "https://dev.azure.com/org/project/_git/repo/pullrequest/12",
+			}
+			must.EqOp(t, want, *have)
+		})
+
+		t.Run("review decision", func(t *testing.T) {
+			t.Parallel()
+			tests := map[string]struct {
+				reviewers string
+				want      hostingdomain.ReviewDecision
+			}{
+				"no reviewers":              {reviewers: `[]`, want: hostingdomain.ReviewDecisionNone},
+				"approved":                  {reviewers: `[{"vote": 5}]`, want: hostingdomain.ReviewDecisionApproved},
+				"waiting for author":        {reviewers: `[{"vote": 10}, {"vote": -5}]`, want: hostingdomain.ReviewDecisionChangesRequested},
+				"required reviewer missing": {reviewers: `[{"vote": 10}, {"vote": 0, "isRequired": true}]`, want: hostingdomain.ReviewDecisionReviewRequired},
+			}
+			for name, tt := range tests {
+				reviewers := tt.reviewers
+				want := tt.want
+				t.Run(name, func(t *testing.T) {
+					t.Parallel()
+					server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(`{"count": 1, "value": [{"pullRequestId": 12, "targetRefName": "refs/heads/main", "reviewers": ` + reviewers + `}]}`))
+					}))
+					defer server.Close()
+					connector := newAPITestConnector(t, server.URL)
+					have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+					must.NoError(t, err)
+					must.EqOp(t, want, have.ReviewDecision)
+				})
+			}
+		})
+
+		t.Run("any target branch", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.False(t, r.URL.Query().Has("searchCriteria.targetRefName"))
+				_, _ = w.Write([]byte(`{"count": 1, "value": [{"pullRequestId": 12, "targetRefName": "refs/heads/parent"}]}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.EmptyLocalBranchName())
+			must.NoError(t, err)
+			must.EqOp(t, gitdomain.NewLocalBranchName("parent"), have.Target)
+		})
+
+		t.Run("merge conflicts", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"count": 1, "value": [{"pullRequestId": 12, "targetRefName": "refs/heads/main", "mergeStatus": "conflicts"}]}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.False(t, have.MergeWithAPI)
+		})
+
+		t.Run("no pull request", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"count": 0, "value": []}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+
+		t.Run("multiple pull requests", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"count": 2, "value": [{"pullRequestId": 1}, {"pullRequestId": 2}]}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.Error(t, err)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "TF401019: The Git repository with name or identifier repo does not exist"}`))
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "TF401019")
+		})
+
+		t.Run("no API token", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "https://dev.azure.com/org/project/_git/repo", "")
+			connector.APIURL = "http://localhost:0"
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid pull request", func(t *testing.T) {
+			t.Parallel()
+			completed := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", r.URL.Path)
+				switch r.Method {
+				case http.MethodGet:
+					_, _ = w.Write([]byte(`{"pullRequestId": 12, "lastMergeSourceCommit": {"commitId": "abc123"}}`))
+				case http.MethodPatch:
+					var body map[string]any
+					must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					must.EqOp(t, "completed", body["status"].(string))
+					must.Eq(t, map[string]any{"commitId": "abc123"}, body["lastMergeSourceCommit"].(map[string]any))
+					must.Eq(t, map[string]any{
+						"deleteSourceBranch": false,
+						"mergeCommitMessage": "title\n\nbody",
+						"mergeStrategy":      "squash",
+					}, body["completionOptions"].(map[string]any))
+					completed = true
+					_, _ = w.Write([]byte(`{"pullRequestId": 12, "status": "completed"}`))
+				}
+			}))
+			defer server.Close()
+			connector := newAPITestConnector(t, server.URL)
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+			must.True(t, completed)
+		})
+
+		t.Run("missing pull request number", func(t *testing.T) {
+			t.Parallel()
+			connector := newAPITestConnector(t, "http://localhost:0")
+			err := connector.SquashMergeProposal(0, "title")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("UpdateProposalBody", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, http.MethodPatch, r.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", r.URL.Path)
+			var body map[string]any
+			must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			must.EqOp(t, "new body", body["description"].(string))
+			_, _ = w.Write([]byte(`{"pullRequestId": 12}`))
+		}))
+		defer server.Close()
+		connector := newAPITestConnector(t, server.URL)
+		err := connector.UpdateProposalBody(12, "new body")
+		must.NoError(t, err)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, http.MethodPatch, r.Method)
+			must.EqOp(t, "/org/project/_apis/git/repositories/repo/pullrequests/12", r.URL.Path)
+			var body map[string]any
+			must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			must.EqOp(t, "refs/heads/new", body["targetRefName"].(string))
+			_, _ = w.Write([]byte(`{"pullRequestId": 12}`))
+		}))
+		defer server.Close()
+		connector := newAPITestConnector(t, server.URL)
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new"))
+		must.NoError(t, err)
+	})
+}
+
+// newAPITestConnector provides a connector that talks to the Azure DevOps API stand-in at the given URL.
+func newAPITestConnector(t *testing.T, apiURL string) *azuredevops.Connector {
+	t.Helper()
+	connector := newTestConnector(t, "https://dev.azure.com/org/project/_git/repo", "secret")
+	connector.APIURL = apiURL
+	return connector
+}
+
+func newTestConnector(t *testing.T, originURL string, token configdomain.AzureDevOpsToken) *azuredevops.Connector {
+	t.Helper()
+	connector, err := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+		APIToken:        token,
+		HostingPlatform: configdomain.HostingPlatformNone,
+		Log:             print.Logger{},
+		OriginURL:       giturl.Parse(originURL),
+	})
+	must.NoError(t, err)
+	return connector
+}
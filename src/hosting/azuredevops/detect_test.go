@@ -0,0 +1,40 @@
+package azuredevops_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/azuredevops"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Azure DevOps via HTTPS", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("https://dev.azure.com/org/project/_git/repo"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("Azure DevOps via SSH", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("hosted service type provided manually", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, azuredevops.Detect(giturl.Parse("git@custom-url.com:git-town/docs.git"), configdomain.HostingPlatformAzureDevOps))
+	})
+
+	t.Run("repo is hosted by another hosting platform", func(t *testing.T) {
+		t.Parallel()
+		must.False(t, azuredevops.Detect(giturl.Parse("git@github.com:git-town/git-town.git"), configdomain.HostingPlatformNone))
+	})
+
+	t.Run("no origin remote", func(t *testing.T) {
+		t.Parallel()
+		var originURL *giturl.Parts
+		must.False(t, azuredevops.Detect(originURL, configdomain.HostingPlatformNone))
+	})
+}
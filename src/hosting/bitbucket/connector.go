@@ -1,10 +1,16 @@
 package bitbucket
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/git/giturl"
@@ -12,34 +18,91 @@ import (
 	"github.com/git-town/git-town/v13/src/messages"
 )
 
+// DefaultAPIURL is the base URL of the Bitbucket Cloud REST API.
+const DefaultAPIURL = "https://api.bitbucket.org/2.0"
+
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Config
+	APIURL      string
+	AppPassword configdomain.BitbucketAppPassword
+	Username    configdomain.BitbucketUsername
+	client      *http.Client
+	log         print.Logger
 }
 
 // NewConnector provides a Bitbucket connector instance if the current repo is hosted on Bitbucket,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
 	return &Connector{
+		APIURL:      DefaultAPIURL,
+		AppPassword: args.AppPassword,
 		Config: hostingdomain.Config{
 			Hostname:     args.OriginURL.Host,
 			Organization: args.OriginURL.Org,
 			Repository:   args.OriginURL.Repo,
 		},
+		Username: args.Username,
+		client:   http.DefaultClient,
+		log:      args.Log,
 	}, nil
 }
 
 type NewConnectorArgs struct {
+	AppPassword     configdomain.BitbucketAppPassword
 	HostingPlatform configdomain.HostingPlatform
+	Log             print.Logger
 	OriginURL       *giturl.Parts
+	Username        configdomain.BitbucketUsername
+}
+
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingBitbucketCreatePRViaAPI, branch, parentBranch)
+	var created pullRequest
+	err := self.request(http.MethodPost, self.pullRequestsURL(), createRequest{
+		Description: body,
+		Destination: endpoint{Branch: branchRef{Name: parentBranch.String()}},
+		Draft:       draft,
+		Source:      endpoint{Branch: branchRef{Name: branch.String()}},
+		Title:       title,
+	}, &created)
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := parsePullRequest(created)
+	return &proposal, nil
 }
 
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
-func (self *Connector) FindProposal(_, _ gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
-	return nil, errors.New(messages.HostingBitBucketNotImplemented)
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	if self.AppPassword == "" {
+		return nil, nil //nolint:nilnil
+	}
+	query := url.Values{}
+	conditions := []string{fmt.Sprintf(`source.branch.name=%q`, branch.String())}
+	if !target.IsEmpty() {
+		conditions = append(conditions, fmt.Sprintf(`destination.branch.name=%q`, target.String()))
+	}
+	conditions = append(conditions, `state="OPEN"`)
+	query.Set("q", strings.Join(conditions, " AND "))
+	var response pullRequestList
+	err := self.request(http.MethodGet, self.pullRequestsURL()+"?"+query.Encode(), nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Values) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	if len(response.Values) > 1 {
+		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(response.Values), branch, target)
+	}
+	proposal := parsePullRequest(response.Values[0])
+	return &proposal, nil
 }
 
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
@@ -56,10 +119,197 @@ func (self *Connector) RepositoryURL() string {
 	return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
 }
 
-func (self *Connector) SquashMergeProposal(_ int, _ gitdomain.CommitMessage) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingBitbucketMergingViaAPI, number)
+	err := self.request(http.MethodPost, fmt.Sprintf("%s/%d/merge", self.pullRequestsURL(), number), mergeRequest{
+		CloseSourceBranch: false,
+		MergeStrategy:     "squash",
+		Message:           message.String(),
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingBitbucketUpdateBodyViaAPI, number)
+	pullRequestURL := fmt.Sprintf("%s/%d", self.pullRequestsURL(), number)
+	// Bitbucket requires the title in every update of a pull request
+	var existing pullRequest
+	err := self.request(http.MethodGet, pullRequestURL, nil, &existing)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPut, pullRequestURL, describeRequest{
+		Description: body,
+		Title:       existing.Title,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingBitbucketUpdatePRViaAPI, number, target)
+	pullRequestURL := fmt.Sprintf("%s/%d", self.pullRequestsURL(), number)
+	// Bitbucket requires the title in every update of a pull request
+	var existing pullRequest
+	err := self.request(http.MethodGet, pullRequestURL, nil, &existing)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	err = self.request(http.MethodPut, pullRequestURL, updateRequest{
+		Destination: endpoint{Branch: branchRef{Name: target.String()}},
+		Title:       existing.Title,
+	}, nil)
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) pullRequestsURL() string {
+	return fmt.Sprintf("%s/repositories/%s/%s/pullrequests", self.APIURL, url.PathEscape(self.Organization), url.PathEscape(self.Repository))
+}
+
+// request sends the given payload as JSON to the given Bitbucket API endpoint
+// and decodes the JSON response into the given result if it isn't nil.
+func (self *Connector) request(method, endpoint string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	request, err := http.NewRequest(method, endpoint, body) //nolint:noctx
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if self.Username != "" {
+		request.SetBasicAuth(self.Username.String(), self.AppPassword.String())
+	} else {
+		request.Header.Set("Authorization", "Bearer "+self.AppPassword.String())
+	}
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(messages.HostingBitbucketAPIProblem, method, endpoint, response.Status, parseErrorMessage(response.Body))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+type branchRef struct {
+	Name string `json:"name"`
+}
+
+type createRequest struct {
+	Description string   `json:"description"`
+	Destination endpoint `json:"destination"`
+	Draft       bool     `json:"draft"`
+	Source      endpoint `json:"source"`
+	Title       string   `json:"title"`
+}
+
+type describeRequest struct {
+	Description string `json:"description"`
+	Title       string `json:"title"`
+}
+
+type endpoint struct {
+	Branch branchRef `json:"branch"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type link struct {
+	Href string `json:"href"`
+}
+
+type mergeRequest struct {
+	CloseSourceBranch bool   `json:"close_source_branch"`
+	MergeStrategy     string `json:"merge_strategy"`
+	Message           string `json:"message"`
+}
+
+type pullRequest struct {
+	Author      user             `json:"author"`
+	Description string           `json:"description"`
+	Destination endpoint         `json:"destination"`
+	Draft       bool             `json:"draft"`
+	ID          int              `json:"id"`
+	Links       pullRequestLinks `json:"links"`
+	State       string           `json:"state"`
+	Title       string           `json:"title"`
+}
+
+type pullRequestLinks struct {
+	HTML link `json:"html"`
+}
+
+type pullRequestList struct {
+	Values []pullRequest `json:"values"`
+}
+
+type updateRequest struct {
+	Destination endpoint `json:"destination"`
+	Title       string   `json:"title"`
+}
+
+type user struct {
+	Nickname string `json:"nickname"`
+}
+
+// parseErrorMessage extracts the human-readable error message from the given Bitbucket API error response.
+func parseErrorMessage(body io.Reader) string {
+	var response errorResponse
+	err := json.NewDecoder(body).Decode(&response)
+	if err != nil {
+		return ""
+	}
+	return response.Error.Message
 }
 
-func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	return errors.New(messages.HostingBitBucketNotImplemented)
+// parsePullRequest extracts standardized proposal data from the given Bitbucket pull request.
+func parsePullRequest(pullRequest pullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Author:         pullRequest.Author.Nickname,
+		Body:           pullRequest.Description,
+		Checks:         hostingdomain.ChecksStatusNone,
+		Draft:          pullRequest.Draft,
+		MergeWithAPI:   pullRequest.State == "OPEN",
+		Number:         pullRequest.ID,
+		ReviewDecision: hostingdomain.ReviewDecisionNone,
+		Target:         gitdomain.NewLocalBranchName(pullRequest.Destination.Branch.Name),
+		Title:          pullRequest.Title,
+		URL:            pullRequest.Links.HTML.Href,
+	}
 }
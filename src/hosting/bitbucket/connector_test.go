@@ -1,8 +1,12 @@
 package bitbucket_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/git/giturl"
@@ -60,4 +64,225 @@ func TestBitbucketConnector(t *testing.T) {
 		want := "https://bitbucket.org/org/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
 		must.EqOp(t, want, have)
 	})
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, http.MethodPost, r.Method)
+			must.EqOp(t, "/repositories/org/repo/pullrequests", r.URL.Path)
+			var body map[string]any
+			must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			must.EqOp(t, "my title", body["title"].(string))
+			must.EqOp(t, "my description", body["description"].(string))
+			must.True(t, body["draft"].(bool))
+			must.Eq(t, map[string]any{"branch": map[string]any{"name": "feature"}}, body["source"].(map[string]any))
+			must.Eq(t, map[string]any{"branch": map[string]any{"name": "main"}}, body["destination"].(map[string]any))
+			_, _ = w.Write([]byte(`{"id": 12, "title": "my title", "draft": true, "state": "OPEN", "destination": {"branch": {"name": "main"}}, "links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}}`))
+		}))
+		defer server.Close()
+		connector := newTestConnector(t, server.URL, "user")
+		have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"), "my title", "my description", true)
+		must.NoError(t, err)
+		must.EqOp(t, 12, have.Number)
+		must.EqOp(t, "https://bitbucket.org/org/repo/pull-requests/12", have.URL)
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("single open pull request", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, "/repositories/org/repo/pullrequests", r.URL.Path)
+				must.EqOp(t, `source.branch.name="feature" AND destination.branch.name="main" AND state="OPEN"`, r.URL.Query().Get("q"))
+				username, password, ok := r.BasicAuth()
+				must.True(t, ok)
+				must.EqOp(t, "user", username)
+				must.EqOp(t, "secret", password)
+				_, _ = w.Write([]byte(`{"values": [{
+					"id": 12,
+					"title": "my title",
+					"description": "my description",
+					"author": {"nickname": "alice"},
+					"draft": true,
+					"state": "OPEN",
+					"destination": {"branch": {"name": "main"}},
+					"links": {"html": {"href": "https://bitbucket.org/org/repo/pull-requests/12"}}
+				}]}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "user")
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			want := hostingdomain.Proposal{
+				Author:         "alice",
+				Body:           "my description",
+				Checks:         hostingdomain.ChecksStatusNone,
+				Draft:          true,
+				MergeWithAPI:   true,
+				Number:         12,
+				ReviewDecision: hostingdomain.ReviewDecisionNone,
+				Target:         gitdomain.NewLocalBranchName("main"),
+				Title:          "my title",
+				URL:            "https://bitbucket.org/org/repo/pull-requests/12",
+			}
+			must.EqOp(t, want, *have)
+		})
+
+		t.Run("any target branch", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, `source.branch.name="feature" AND state="OPEN"`, r.URL.Query().Get("q"))
+				_, _ = w.Write([]byte(`{"values": [{"id": 12, "destination": {"branch": {"name": "parent"}}}]}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "user")
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.EmptyLocalBranchName())
+			must.NoError(t, err)
+			must.EqOp(t, gitdomain.NewLocalBranchName("parent"), have.Target)
+		})
+
+		t.Run("no pull request", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, "Bearer secret", r.Header.Get("Authorization"))
+				_, _ = w.Write([]byte(`{"values": []}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "")
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+
+		t.Run("multiple pull requests", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"values": [{"id": 1}, {"id": 2}]}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "")
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.Error(t, err)
+		})
+
+		t.Run("API error", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"type": "error", "error": {"message": "Invalid credentials"}}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "")
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "Invalid credentials")
+		})
+
+		t.Run("no credentials", func(t *testing.T) {
+			t.Parallel()
+			connector, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+				AppPassword:     "",
+				HostingPlatform: configdomain.HostingPlatformNone,
+				Log:             print.Logger{},
+				OriginURL:       giturl.Parse("git@bitbucket.org:org/repo.git"),
+				Username:        "",
+			})
+			must.NoError(t, err)
+			connector.APIURL = "http://localhost:0"
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid pull request", func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				must.EqOp(t, http.MethodPost, r.Method)
+				must.EqOp(t, "/repositories/org/repo/pullrequests/12/merge", r.URL.Path)
+				var body map[string]any
+				must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				must.EqOp(t, "squash", body["merge_strategy"].(string))
+				must.EqOp(t, "title\n\nbody", body["message"].(string))
+				must.False(t, body["close_source_branch"].(bool))
+				_, _ = w.Write([]byte(`{"id": 12, "state": "MERGED"}`))
+			}))
+			defer server.Close()
+			connector := newTestConnector(t, server.URL, "user")
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+		})
+
+		t.Run("missing pull request number", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "http://localhost:0", "user")
+			err := connector.SquashMergeProposal(0, "title")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("UpdateProposalBody", func(t *testing.T) {
+		t.Parallel()
+		updated := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12", r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"id": 12, "title": "my title", "state": "OPEN", "description": "old body"}`))
+			case http.MethodPut:
+				var body map[string]any
+				must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				must.EqOp(t, "my title", body["title"].(string))
+				must.EqOp(t, "new body", body["description"].(string))
+				updated = true
+				_, _ = w.Write([]byte(`{"id": 12}`))
+			}
+		}))
+		defer server.Close()
+		connector := newTestConnector(t, server.URL, "user")
+		err := connector.UpdateProposalBody(12, "new body")
+		must.NoError(t, err)
+		must.True(t, updated)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		updated := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			must.EqOp(t, "/repositories/org/repo/pullrequests/12", r.URL.Path)
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"id": 12, "title": "my title", "state": "OPEN", "destination": {"branch": {"name": "old"}}}`))
+			case http.MethodPut:
+				var body map[string]any
+				must.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				must.EqOp(t, "my title", body["title"].(string))
+				must.Eq(t, map[string]any{"branch": map[string]any{"name": "new"}}, body["destination"].(map[string]any))
+				updated = true
+				_, _ = w.Write([]byte(`{"id": 12}`))
+			}
+		}))
+		defer server.Close()
+		connector := newTestConnector(t, server.URL, "user")
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new"))
+		must.NoError(t, err)
+		must.True(t, updated)
+	})
+}
+
+func newTestConnector(t *testing.T, apiURL string, username configdomain.BitbucketUsername) *bitbucket.Connector {
+	t.Helper()
+	connector, err := bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+		AppPassword:     "secret",
+		HostingPlatform: configdomain.HostingPlatformNone,
+		Log:             print.Logger{},
+		OriginURL:       giturl.Parse("git@bitbucket.org:org/repo.git"),
+		Username:        username,
+	})
+	must.NoError(t, err)
+	connector.APIURL = apiURL
+	return connector
 }
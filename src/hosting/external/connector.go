@@ -0,0 +1,222 @@
+// Package external provides a hosting connector that delegates all work to a user-provided executable.
+//
+// Git Town runs the executable once per connector method call,
+// sends a JSON-encoded Request to its STDIN,
+// and reads a JSON-encoded Response from its STDOUT.
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+)
+
+// Connector talks to the hosting platform through an external executable.
+type Connector struct {
+	hostingdomain.Config
+	Command configdomain.HostingConnectorCommand
+	log     print.Logger
+}
+
+// NewConnector provides a Connector instance that talks to the given executable.
+func NewConnector(args NewConnectorArgs) (*Connector, error) {
+	argv, err := args.Command.Argv()
+	if err != nil {
+		return nil, fmt.Errorf(messages.HostingExternalCommandInvalid, args.Command, err)
+	}
+	if len(argv) == 0 {
+		return nil, errors.New(messages.HostingExternalCommandEmpty)
+	}
+	config := hostingdomain.Config{
+		Hostname:     "",
+		Organization: "",
+		Repository:   "",
+	}
+	if args.OriginURL != nil {
+		config.Hostname = args.OriginURL.Host
+		config.Organization = args.OriginURL.Org
+		config.Repository = args.OriginURL.Repo
+	}
+	return &Connector{
+		Command: args.Command,
+		Config:  config,
+		log:     args.Log,
+	}, nil
+}
+
+type NewConnectorArgs struct {
+	Command   configdomain.HostingConnectorCommand
+	Log       print.Logger
+	OriginURL *giturl.Parts
+}
+
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingExternalCreateViaCommand, branch, parentBranch)
+	response, err := self.call(Request{ //nolint:exhaustruct
+		Body:   body,
+		Branch: branch.String(),
+		Draft:  draft,
+		Method: MethodCreateProposal,
+		Target: parentBranch.String(),
+		Title:  title,
+	})
+	if err == nil && response.Proposal == nil {
+		err = fmt.Errorf(messages.HostingExternalCommandNoProposal, self.Command)
+	}
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := response.Proposal.Proposal()
+	return &proposal, nil
+}
+
+func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	self.log.Start(messages.HostingExternalDefaultMessage, proposal.Number)
+	data := newProposalData(proposal)
+	response, err := self.call(Request{ //nolint:exhaustruct
+		Method:   MethodDefaultProposalMessage,
+		Proposal: &data,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
+	}
+	self.log.Success()
+	return response.Message
+}
+
+func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	response, err := self.call(Request{ //nolint:exhaustruct
+		Branch: branch.String(),
+		Method: MethodFindProposal,
+		Target: target.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Proposal == nil {
+		return nil, nil //nolint:nilnil
+	}
+	proposal := response.Proposal.Proposal()
+	return &proposal, nil
+}
+
+func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
+	response, err := self.call(Request{ //nolint:exhaustruct
+		Branch: branch.String(),
+		Method: MethodNewProposalURL,
+		Target: parentBranch.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.URL, nil
+}
+
+func (self *Connector) RepositoryURL() string {
+	self.log.Start(messages.HostingExternalRepositoryURL)
+	response, err := self.call(Request{ //nolint:exhaustruct
+		Method: MethodRepositoryURL,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return fmt.Sprintf("https://%s/%s/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+	}
+	self.log.Success()
+	return response.URL
+}
+
+func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingExternalMergingViaCommand, number)
+	_, err := self.call(Request{ //nolint:exhaustruct
+		Message: message.String(),
+		Method:  MethodSquashMergeProposal,
+		Number:  number,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingExternalUpdateBodyViaCommand, number)
+	_, err := self.call(Request{ //nolint:exhaustruct
+		Body:   body,
+		Method: MethodUpdateProposalBody,
+		Number: number,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingExternalUpdatePRViaCommand, number, target)
+	_, err := self.call(Request{ //nolint:exhaustruct
+		Method: MethodUpdateProposalTarget,
+		Number: number,
+		Target: target.String(),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+// call sends the given request to the external executable and provides its response.
+func (self *Connector) call(request Request) (Response, error) {
+	request.Repository = Repository{
+		Hostname:     self.Hostname,
+		Organization: self.Organization,
+		Repository:   self.Repository,
+	}
+	input, err := json.Marshal(request)
+	if err != nil {
+		return Response{}, err //nolint:exhaustruct
+	}
+	argv, err := self.Command.Argv()
+	if err != nil {
+		return Response{}, fmt.Errorf(messages.HostingExternalCommandInvalid, self.Command, err) //nolint:exhaustruct
+	}
+	subProcess := exec.Command(argv[0], argv[1:]...) // #nosec
+	subProcess.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	subProcess.Stdout = &stdout
+	subProcess.Stderr = &stderr
+	err = subProcess.Run()
+	if err != nil {
+		return Response{}, fmt.Errorf(messages.HostingExternalCommandFailed, self.Command, request.Method, err, strings.TrimSpace(stderr.String())) //nolint:exhaustruct
+	}
+	var response Response
+	err = json.Unmarshal(stdout.Bytes(), &response)
+	if err != nil {
+		return Response{}, fmt.Errorf(messages.HostingExternalCommandInvalidOutput, self.Command, request.Method, err) //nolint:exhaustruct
+	}
+	if response.Error != "" {
+		return response, errors.New(response.Error)
+	}
+	return response, nil
+}
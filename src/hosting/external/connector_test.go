@@ -0,0 +1,286 @@
+package external_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/external"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CreateProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("command creates the proposal", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			have, err := connector.CreateProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"), "my title", "my body", true)
+			must.NoError(t, err)
+			must.EqOp(t, 13, have.Number)
+			must.EqOp(t, "my title", have.Title)
+			must.True(t, have.Draft)
+			must.EqOp(t, "https://review.example.com/org/repo/13", have.URL)
+		})
+
+		t.Run("command provides no proposal", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			_, err := connector.CreateProposal(gitdomain.NewLocalBranchName("other"), gitdomain.NewLocalBranchName("main"), "my title", "", false)
+			must.ErrorContains(t, err, "did not provide the created proposal")
+		})
+	})
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "ok")
+		have := connector.DefaultProposalMessage(hostingdomain.Proposal{
+			MergeWithAPI: true,
+			Number:       12,
+			Target:       gitdomain.NewLocalBranchName("main"),
+			Title:        "my title",
+		})
+		must.EqOp(t, "my title [12]", have)
+	})
+
+	t.Run("FindProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			want := hostingdomain.Proposal{
+				Author:         "alice",
+				Body:           "my body",
+				Checks:         hostingdomain.ChecksStatusPassing,
+				Draft:          false,
+				MergeWithAPI:   true,
+				Number:         12,
+				ReviewDecision: hostingdomain.ReviewDecisionApproved,
+				Target:         gitdomain.NewLocalBranchName("main"),
+				Title:          "feature in org/repo",
+				URL:            "https://review.example.com/org/repo/12",
+			}
+			must.EqOp(t, want, *have)
+		})
+
+		t.Run("no proposal", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			have, err := connector.FindProposal(gitdomain.NewLocalBranchName("other"), gitdomain.NewLocalBranchName("main"))
+			must.NoError(t, err)
+			must.Nil(t, have)
+		})
+
+		t.Run("command reports an error", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "error")
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "review server unavailable")
+		})
+
+		t.Run("command crashes", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "crash")
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "something went wrong")
+		})
+
+		t.Run("command prints invalid JSON", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "garbage")
+			_, err := connector.FindProposal(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+			must.ErrorContains(t, err, "invalid output")
+		})
+	})
+
+	t.Run("NewConnector", func(t *testing.T) {
+		t.Parallel()
+		_, err := external.NewConnector(external.NewConnectorArgs{
+			Command:   " ",
+			Log:       print.Logger{},
+			OriginURL: giturl.Parse("git@example.com:org/repo.git"),
+		})
+		must.Error(t, err)
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "ok")
+		have, err := connector.NewProposalURL(gitdomain.NewLocalBranchName("feature"), gitdomain.NewLocalBranchName("main"))
+		must.NoError(t, err)
+		must.EqOp(t, "https://review.example.com/new?from=feature&to=main", have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("command succeeds", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			must.EqOp(t, "https://review.example.com/org/repo", connector.RepositoryURL())
+		})
+
+		t.Run("command fails", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "crash")
+			must.EqOp(t, "https://example.com/org/repo", connector.RepositoryURL())
+		})
+	})
+
+	t.Run("SquashMergeProposal", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("valid proposal", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			err := connector.SquashMergeProposal(12, "title\n\nbody")
+			must.NoError(t, err)
+		})
+
+		t.Run("command rejects the merge", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			err := connector.SquashMergeProposal(13, "title")
+			must.ErrorContains(t, err, "proposal 13 is not approved")
+		})
+
+		t.Run("missing proposal number", func(t *testing.T) {
+			t.Parallel()
+			connector := newTestConnector(t, "ok")
+			err := connector.SquashMergeProposal(0, "title")
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("UpdateProposalBody", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "ok")
+		err := connector.UpdateProposalBody(12, "new body")
+		must.NoError(t, err)
+	})
+
+	t.Run("UpdateProposalTarget", func(t *testing.T) {
+		t.Parallel()
+		connector := newTestConnector(t, "ok")
+		err := connector.UpdateProposalTarget(12, gitdomain.NewLocalBranchName("new"))
+		must.NoError(t, err)
+	})
+}
+
+// TestHelperConnector is not a real test.
+// It is the external connector executable used by the tests above.
+//
+//nolint:paralleltest
+func TestHelperConnector(t *testing.T) {
+	mode := ""
+	for a, arg := range os.Args {
+		if arg == "--" && a+1 < len(os.Args) {
+			mode = os.Args[a+1]
+		}
+	}
+	if mode == "" {
+		return
+	}
+	var request external.Request
+	err := json.NewDecoder(os.Stdin).Decode(&request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch mode {
+	case "crash":
+		fmt.Fprintln(os.Stderr, "something went wrong")
+		os.Exit(1)
+	case "error":
+		respond(external.Response{Error: "review server unavailable"}) //nolint:exhaustruct
+	case "garbage":
+		fmt.Println("this is not JSON")
+	case "ok":
+		respond(answer(request))
+	}
+	os.Exit(0)
+}
+
+// answer provides the response of a well-behaved external connector to the given request.
+func answer(request external.Request) external.Response {
+	repo := request.Repository.Organization + "/" + request.Repository.Repository
+	switch request.Method {
+	case external.MethodCreateProposal:
+		if request.Branch != "feature" {
+			return external.Response{} //nolint:exhaustruct
+		}
+		return external.Response{Proposal: &external.ProposalData{ //nolint:exhaustruct
+			Body:         request.Body,
+			Draft:        request.Draft,
+			MergeWithAPI: true,
+			Number:       13,
+			Target:       request.Target,
+			Title:        request.Title,
+			URL:          "https://review.example.com/" + repo + "/13",
+		}}
+	case external.MethodDefaultProposalMessage:
+		return external.Response{Message: fmt.Sprintf("%s [%d]", request.Proposal.Title, request.Proposal.Number)} //nolint:exhaustruct
+	case external.MethodFindProposal:
+		if request.Branch != "feature" {
+			return external.Response{} //nolint:exhaustruct
+		}
+		return external.Response{Proposal: &external.ProposalData{ //nolint:exhaustruct
+			Author:         "alice",
+			Body:           "my body",
+			Checks:         "passing",
+			MergeWithAPI:   true,
+			Number:         12,
+			ReviewDecision: "approved",
+			Target:         request.Target,
+			Title:          request.Branch + " in " + repo,
+			URL:            "https://review.example.com/" + repo + "/12",
+		}}
+	case external.MethodNewProposalURL:
+		return external.Response{URL: fmt.Sprintf("https://review.example.com/new?from=%s&to=%s", request.Branch, request.Target)} //nolint:exhaustruct
+	case external.MethodRepositoryURL:
+		return external.Response{URL: "https://review.example.com/" + repo} //nolint:exhaustruct
+	case external.MethodSquashMergeProposal:
+		if request.Number != 12 || request.Message != "title\n\nbody" {
+			return external.Response{Error: fmt.Sprintf("proposal %d is not approved", request.Number)} //nolint:exhaustruct
+		}
+		return external.Response{} //nolint:exhaustruct
+	case external.MethodUpdateProposalBody:
+		if request.Number != 12 || request.Body != "new body" {
+			return external.Response{Error: "unexpected request"} //nolint:exhaustruct
+		}
+		return external.Response{} //nolint:exhaustruct
+	case external.MethodUpdateProposalTarget:
+		if request.Number != 12 || request.Target != "new" {
+			return external.Response{Error: "unexpected request"} //nolint:exhaustruct
+		}
+		return external.Response{} //nolint:exhaustruct
+	}
+	return external.Response{Error: "unknown method: " + string(request.Method)} //nolint:exhaustruct
+}
+
+func newTestConnector(t *testing.T, mode string) *external.Connector {
+	t.Helper()
+	command := configdomain.HostingConnectorCommand(fmt.Sprintf("%s -test.run=^TestHelperConnector$ -- %s", os.Args[0], mode))
+	connector, err := external.NewConnector(external.NewConnectorArgs{
+		Command:   command,
+		Log:       print.Logger{},
+		OriginURL: giturl.Parse("git@example.com:org/repo.git"),
+	})
+	must.NoError(t, err)
+	return connector
+}
+
+func respond(response external.Response) {
+	_ = json.NewEncoder(os.Stdout).Encode(response)
+}
@@ -0,0 +1,122 @@
+package external
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+)
+
+// Method names the hostingdomain.Connector method that a Request invokes.
+type Method string
+
+const (
+	MethodCreateProposal         Method = "CreateProposal"
+	MethodDefaultProposalMessage Method = "DefaultProposalMessage"
+	MethodFindProposal           Method = "FindProposal"
+	MethodNewProposalURL         Method = "NewProposalURL"
+	MethodRepositoryURL          Method = "RepositoryURL"
+	MethodSquashMergeProposal    Method = "SquashMergeProposal"
+	MethodUpdateProposalBody     Method = "UpdateProposalBody"
+	MethodUpdateProposalTarget   Method = "UpdateProposalTarget"
+)
+
+// ProposalData is the JSON representation of a hostingdomain.Proposal.
+type ProposalData struct {
+	Author         string `json:"Author,omitempty"`
+	Body           string `json:"Body,omitempty"`
+	Checks         string `json:"Checks,omitempty"`
+	Draft          bool   `json:"Draft,omitempty"`
+	MergeWithAPI   bool   `json:"MergeWithAPI"`
+	Number         int    `json:"Number"`
+	ReviewDecision string `json:"ReviewDecision,omitempty"`
+	Target         string `json:"Target"`
+	Title          string `json:"Title"`
+	URL            string `json:"URL,omitempty"`
+}
+
+func newProposalData(proposal hostingdomain.Proposal) ProposalData {
+	return ProposalData{
+		Author:         proposal.Author,
+		Body:           proposal.Body,
+		Checks:         proposal.Checks.String(),
+		Draft:          proposal.Draft,
+		MergeWithAPI:   proposal.MergeWithAPI,
+		Number:         proposal.Number,
+		ReviewDecision: proposal.ReviewDecision.String(),
+		Target:         proposal.Target.String(),
+		Title:          proposal.Title,
+		URL:            proposal.URL,
+	}
+}
+
+// Proposal provides the hostingdomain.Proposal described by this ProposalData.
+func (self ProposalData) Proposal() hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Author:         self.Author,
+		Body:           self.Body,
+		Checks:         hostingdomain.ChecksStatus(self.Checks),
+		Draft:          self.Draft,
+		MergeWithAPI:   self.MergeWithAPI,
+		Number:         self.Number,
+		ReviewDecision: hostingdomain.ReviewDecision(self.ReviewDecision),
+		Target:         gitdomain.NewLocalBranchName(self.Target),
+		Title:          self.Title,
+		URL:            self.URL,
+	}
+}
+
+// Repository describes the repository that a Request is about.
+type Repository struct {
+	Hostname     string `json:"Hostname"`
+	Organization string `json:"Organization"`
+	Repository   string `json:"Repository"`
+}
+
+// Request is the data that Git Town sends to the STDIN of the external executable.
+// Only the fields used by the respective method are populated.
+type Request struct {
+	// the description of the proposal to create or update
+	Body string `json:"Body,omitempty"`
+
+	// the branch to find or create a proposal for
+	Branch string `json:"Branch,omitempty"`
+
+	// whether to create the proposal as a draft
+	Draft bool `json:"Draft,omitempty"`
+
+	// the commit message to use when merging a proposal
+	Message string `json:"Message,omitempty"`
+
+	// the hostingdomain.Connector method to execute
+	Method Method `json:"Method"`
+
+	// the number of the proposal to merge or update
+	Number int `json:"Number,omitempty"`
+
+	// the proposal to provide the default commit message for
+	Proposal *ProposalData `json:"Proposal,omitempty"`
+
+	// the repository that this request is about
+	Repository Repository `json:"Repository"`
+
+	// the target branch of the proposal to find, create, or update
+	Target string `json:"Target,omitempty"`
+
+	// the title of the proposal to create
+	Title string `json:"Title,omitempty"`
+}
+
+// Response is the data that the external executable prints to STDOUT.
+type Response struct {
+	// a human-readable description of the problem if the method failed
+	Error string `json:"Error,omitempty"`
+
+	// the commit message requested by DefaultProposalMessage
+	Message string `json:"Message,omitempty"`
+
+	// the proposal found by FindProposal, null if there is none,
+	// or the proposal created by CreateProposal
+	Proposal *ProposalData `json:"Proposal,omitempty"`
+
+	// the URL requested by NewProposalURL or RepositoryURL
+	URL string `json:"URL,omitempty"`
+}
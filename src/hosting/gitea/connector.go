@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/git-town/git-town/v13/src/cli/print"
@@ -16,6 +18,14 @@ import (
 	"golang.org/x/oauth2"
 )
 
+const (
+	// MinVersionReviews is the version constraint for Gitea servers that provide the reviews of pull requests via the API.
+	MinVersionReviews = ">= 1.12.0"
+
+	// MinVersionUpdateProposalTarget is the version constraint for Gitea servers that allow changing the base branch of pull requests via the API.
+	MinVersionUpdateProposalTarget = ">= 1.12.0"
+)
+
 type Connector struct {
 	hostingdomain.Config
 	APIToken configdomain.GiteaToken
@@ -23,6 +33,27 @@ type Connector struct {
 	log      print.Logger
 }
 
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGiteaCreatePRViaAPI, branch, parentBranch)
+	if draft {
+		// Gitea marks pull requests as drafts via their title
+		title = "WIP: " + title
+	}
+	pullRequest, _, err := self.client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{ //nolint:exhaustruct
+		Base:  parentBranch.String(),
+		Body:  body,
+		Head:  branch.String(),
+		Title: title,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := ParsePullRequest(pullRequest)
+	return &proposal, nil
+}
+
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
@@ -45,12 +76,23 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
 	}
 	pullRequest := pullRequests[0]
-	return &hostingdomain.Proposal{
-		MergeWithAPI: pullRequest.Mergeable,
-		Number:       int(pullRequest.Index),
-		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
-		Title:        pullRequest.Title,
-	}, nil
+	proposal := ParsePullRequest(pullRequest)
+	// The review decision and checks status are optional,
+	// they remain empty if the API token has no access to them.
+	// Older Gitea versions don't know about reviews, proposals on them have no review decision.
+	if self.client.CheckServerVersionConstraint(MinVersionReviews) == nil {
+		reviews, _, err := self.client.ListPullReviews(self.Organization, self.Repository, pullRequest.Index, gitea.ListPullReviewsOptions{})
+		if err == nil {
+			proposal.ReviewDecision = ParseReviewDecision(reviews)
+		}
+	}
+	if pullRequest.Head != nil && pullRequest.Head.Sha != "" {
+		combinedStatus, _, err := self.client.GetCombinedStatus(self.Organization, self.Repository, pullRequest.Head.Sha)
+		if err == nil {
+			proposal.Checks = ParseChecksStatus(combinedStatus)
+		}
+	}
+	return &proposal, nil
 }
 
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
@@ -79,16 +121,53 @@ func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitM
 	return err
 }
 
-func (self *Connector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
-	// TODO: update the client and uncomment
-	// if self.log != nil {
-	// 	self.log(message.HostingGiteaUpdateBasebranchViaAPI, number, target)
-	// }
-	// _, err := self.client.EditPullRequest(self.owner, self.repository, int64(number), gitea.EditPullRequestOption{
-	// 	Base: newBaseName,
-	// })
-	// return err
-	return errors.New(messages.HostingGiteaNotImplemented)
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGiteaUpdateBodyViaAPI, number)
+	// The Gitea API overwrites the title with the given value,
+	// so we have to provide the existing one.
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Body:  body,
+		Title: pullRequest.Title,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
+func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
+	self.log.Start(messages.HostingGiteaUpdatePRViaAPI, number, target)
+	err := self.client.CheckServerVersionConstraint(MinVersionUpdateProposalTarget)
+	if err != nil {
+		err = fmt.Errorf(messages.HostingGiteaUpdatePRUnsupported, MinVersionUpdateProposalTarget, err)
+		self.log.Failed(err)
+		return err
+	}
+	// The Gitea API overwrites the title and body with the given values,
+	// so we have to provide the existing ones.
+	pullRequest, _, err := self.client.GetPullRequest(self.Organization, self.Repository, int64(number))
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	_, _, err = self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Base:  target.String(),
+		Body:  pullRequest.Body,
+		Title: pullRequest.Title,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
 }
 
 func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string, branch, target gitdomain.LocalBranchName) []*gitea.PullRequest {
@@ -96,18 +175,94 @@ func FilterPullRequests(pullRequests []*gitea.PullRequest, organization string,
 	headName := organization + "/" + branch.String()
 	for p := range pullRequests {
 		pullRequest := pullRequests[p]
-		if pullRequest.Head.Name == headName && pullRequest.Base.Name == target.String() {
+		if pullRequest.Head.Name == headName && (target.IsEmpty() || pullRequest.Base.Name == target.String()) {
 			result = append(result, pullRequest)
 		}
 	}
 	return result
 }
 
+// ParseChecksStatus provides the standardized checks status for the given combined commit status.
+func ParseChecksStatus(combinedStatus *gitea.CombinedStatus) hostingdomain.ChecksStatus {
+	if combinedStatus == nil || combinedStatus.TotalCount == 0 {
+		return hostingdomain.ChecksStatusNone
+	}
+	switch combinedStatus.State {
+	case gitea.StatusError, gitea.StatusFailure:
+		return hostingdomain.ChecksStatusFailing
+	case gitea.StatusPending:
+		return hostingdomain.ChecksStatusPending
+	case gitea.StatusSuccess, gitea.StatusWarning:
+		return hostingdomain.ChecksStatusPassing
+	}
+	return hostingdomain.ChecksStatusNone
+}
+
+// ParsePullRequest extracts standardized proposal data from the given Gitea pull request.
+func ParsePullRequest(pullRequest *gitea.PullRequest) hostingdomain.Proposal {
+	author := ""
+	if pullRequest.Poster != nil {
+		author = pullRequest.Poster.UserName
+	}
+	return hostingdomain.Proposal{
+		Author:         author,
+		Body:           pullRequest.Body,
+		Checks:         hostingdomain.ChecksStatusNone,
+		Draft:          isWorkInProgress(pullRequest.Title),
+		MergeWithAPI:   pullRequest.Mergeable,
+		Number:         int(pullRequest.Index),
+		ReviewDecision: hostingdomain.ReviewDecisionNone,
+		Target:         gitdomain.NewLocalBranchName(pullRequest.Base.Ref),
+		Title:          pullRequest.Title,
+		URL:            pullRequest.HTMLURL,
+	}
+}
+
+// ParseReviewDecision determines the review decision of a pull request from its reviews in chronological order.
+func ParseReviewDecision(reviews []*gitea.PullReview) hostingdomain.ReviewDecision {
+	// only the most recent review of each reviewer counts
+	latestReviews := map[string]gitea.ReviewStateType{}
+	for _, review := range reviews {
+		if review.Dismissed || review.Reviewer == nil {
+			continue
+		}
+		switch review.State {
+		case gitea.ReviewStateApproved, gitea.ReviewStateRequestChanges, gitea.ReviewStateRequestReview:
+			latestReviews[review.Reviewer.UserName] = review.State
+		case gitea.ReviewStateComment, gitea.ReviewStatePending, gitea.ReviewStateUnknown:
+		}
+	}
+	approved := false
+	reviewRequested := false
+	for _, state := range latestReviews {
+		switch state {
+		case gitea.ReviewStateRequestChanges:
+			return hostingdomain.ReviewDecisionChangesRequested
+		case gitea.ReviewStateApproved:
+			approved = true
+		case gitea.ReviewStateRequestReview:
+			reviewRequested = true
+		case gitea.ReviewStateComment, gitea.ReviewStatePending, gitea.ReviewStateUnknown:
+		}
+	}
+	switch {
+	case approved:
+		return hostingdomain.ReviewDecisionApproved
+	case reviewRequested:
+		return hostingdomain.ReviewDecisionReviewRequired
+	}
+	return hostingdomain.ReviewDecisionNone
+}
+
 // NewGiteaConfig provides Gitea configuration data if the current repo is hosted on Gitea,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (*Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
-	httpClient := oauth2.NewClient(context.Background(), tokenSource)
+	ctx := context.Background()
+	if args.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, args.HTTPClient)
+	}
+	httpClient := oauth2.NewClient(ctx, tokenSource)
 	giteaClient := gitea.NewClientWithHTTP("https://"+args.OriginURL.Host, httpClient)
 	return &Connector{
 		APIToken: args.APIToken,
@@ -123,7 +278,15 @@ func NewConnector(args NewConnectorArgs) (*Connector, error) {
 
 type NewConnectorArgs struct {
 	APIToken        configdomain.GiteaToken
+	HTTPClient      *http.Client // the HTTP client to talk to the Gitea API, uses the default client if nil
 	HostingPlatform configdomain.HostingPlatform
 	Log             print.Logger
 	OriginURL       *giturl.Parts
 }
+
+// isWorkInProgress indicates whether the given pull request title marks the pull request as a draft.
+// These are the default work-in-progress prefixes of Gitea.
+func isWorkInProgress(title string) bool {
+	upperTitle := strings.ToUpper(title)
+	return strings.HasPrefix(upperTitle, "WIP:") || strings.HasPrefix(upperTitle, "[WIP]")
+}
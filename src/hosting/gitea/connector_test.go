@@ -1,10 +1,18 @@
 package gitea_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 
 	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/git-town/git-town/v13/src/cli/print"
+	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/git/giturl"
 	"github.com/git-town/git-town/v13/src/hosting/gitea"
 	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
@@ -12,56 +20,205 @@ import (
 
 func TestFilterGiteaPullRequests(t *testing.T) {
 	t.Parallel()
-	give := []*giteasdk.PullRequest{
-		// matching branch
-		{
-			Head: &giteasdk.PRBranchInfo{
-				Name: "organization/branch",
+
+	t.Run("given target", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullRequest{
+			// matching branch
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-			Base: &giteasdk.PRBranchInfo{
-				Name: "target",
+			// branch with different name
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/other",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-		},
-		// branch with different name
-		{
-			Head: &giteasdk.PRBranchInfo{
-				Name: "organization/other",
+			// branch with different target
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "other",
+				},
 			},
-			Base: &giteasdk.PRBranchInfo{
-				Name: "target",
+			// branch with different organization
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "other/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-		},
-		// branch with different target
-		{
-			Head: &giteasdk.PRBranchInfo{
-				Name: "organization/branch",
+		}
+		want := []*giteasdk.PullRequest{
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-			Base: &giteasdk.PRBranchInfo{
-				Name: "other",
+		}
+		have := gitea.FilterPullRequests(give, "organization", gitdomain.NewLocalBranchName("branch"), gitdomain.NewLocalBranchName("target"))
+		must.Eq(t, want, have)
+	})
+
+	t.Run("any target", func(t *testing.T) {
+		t.Parallel()
+		give := []*giteasdk.PullRequest{
+			// matching branch
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-		},
-		// branch with different organization
-		{
-			Head: &giteasdk.PRBranchInfo{
-				Name: "other/branch",
+			// branch with different name
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/other",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
-			Base: &giteasdk.PRBranchInfo{
-				Name: "target",
+		}
+		want := []*giteasdk.PullRequest{
+			{
+				Head: &giteasdk.PRBranchInfo{
+					Name: "organization/branch",
+				},
+				Base: &giteasdk.PRBranchInfo{
+					Name: "target",
+				},
 			},
+		}
+		have := gitea.FilterPullRequests(give, "organization", gitdomain.NewLocalBranchName("branch"), gitdomain.EmptyLocalBranchName())
+		must.Eq(t, want, have)
+	})
+}
+
+func TestParseChecksStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		give *giteasdk.CombinedStatus
+		want hostingdomain.ChecksStatus
+	}{
+		"no statuses": {
+			give: &giteasdk.CombinedStatus{State: giteasdk.StatusPending, TotalCount: 0}, //nolint:exhaustruct
+			want: hostingdomain.ChecksStatusNone,
+		},
+		"pending": {
+			give: &giteasdk.CombinedStatus{State: giteasdk.StatusPending, TotalCount: 2}, //nolint:exhaustruct
+			want: hostingdomain.ChecksStatusPending,
+		},
+		"success": {
+			give: &giteasdk.CombinedStatus{State: giteasdk.StatusSuccess, TotalCount: 2}, //nolint:exhaustruct
+			want: hostingdomain.ChecksStatusPassing,
+		},
+		"failure": {
+			give: &giteasdk.CombinedStatus{State: giteasdk.StatusFailure, TotalCount: 2}, //nolint:exhaustruct
+			want: hostingdomain.ChecksStatusFailing,
 		},
 	}
-	want := []*giteasdk.PullRequest{
-		{
-			Head: &giteasdk.PRBranchInfo{
-				Name: "organization/branch",
-			},
-			Base: &giteasdk.PRBranchInfo{
-				Name: "target",
-			},
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := gitea.ParseChecksStatus(tt.give)
+			must.EqOp(t, tt.want, have)
+		})
+	}
+}
+
+func TestParsePullRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ready for review", func(t *testing.T) {
+		t.Parallel()
+		give := &giteasdk.PullRequest{ //nolint:exhaustruct
+			Base:      &giteasdk.PRBranchInfo{Ref: "main"}, //nolint:exhaustruct
+			Body:      "my body",
+			HTMLURL:   "https://gitea.com/org/repo/pulls/3",
+			Index:     3,
+			Mergeable: true,
+			Poster:    &giteasdk.User{UserName: "alice"}, //nolint:exhaustruct
+			Title:     "my title",
+		}
+		have := gitea.ParsePullRequest(give)
+		want := hostingdomain.Proposal{
+			Author:         "alice",
+			Body:           "my body",
+			Checks:         hostingdomain.ChecksStatusNone,
+			Draft:          false,
+			MergeWithAPI:   true,
+			Number:         3,
+			ReviewDecision: hostingdomain.ReviewDecisionNone,
+			Target:         gitdomain.NewLocalBranchName("main"),
+			Title:          "my title",
+			URL:            "https://gitea.com/org/repo/pulls/3",
+		}
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("work in progress", func(t *testing.T) {
+		t.Parallel()
+		give := &giteasdk.PullRequest{ //nolint:exhaustruct
+			Base:  &giteasdk.PRBranchInfo{Ref: "main"}, //nolint:exhaustruct
+			Title: "WIP: my title",
+		}
+		have := gitea.ParsePullRequest(give)
+		must.True(t, have.Draft)
+	})
+}
+
+func TestParseReviewDecision(t *testing.T) {
+	t.Parallel()
+	review := func(user string, state giteasdk.ReviewStateType) *giteasdk.PullReview {
+		return &giteasdk.PullReview{ //nolint:exhaustruct
+			Reviewer: &giteasdk.User{UserName: user}, //nolint:exhaustruct
+			State:    state,
+		}
+	}
+	tests := map[string]struct {
+		give []*giteasdk.PullReview
+		want hostingdomain.ReviewDecision
+	}{
+		"no reviews": {
+			give: []*giteasdk.PullReview{},
+			want: hostingdomain.ReviewDecisionNone,
+		},
+		"review requested": {
+			give: []*giteasdk.PullReview{review("alice", giteasdk.ReviewStateRequestReview)},
+			want: hostingdomain.ReviewDecisionReviewRequired,
+		},
+		"approved": {
+			give: []*giteasdk.PullReview{review("alice", giteasdk.ReviewStateRequestReview), review("alice", giteasdk.ReviewStateApproved)},
+			want: hostingdomain.ReviewDecisionApproved,
+		},
+		"changes requested": {
+			give: []*giteasdk.PullReview{review("alice", giteasdk.ReviewStateApproved), review("bob", giteasdk.ReviewStateRequestChanges)},
+			want: hostingdomain.ReviewDecisionChangesRequested,
 		},
 	}
-	have := gitea.FilterPullRequests(give, "organization", gitdomain.NewLocalBranchName("branch"), gitdomain.NewLocalBranchName("target"))
-	must.Eq(t, want, have)
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := gitea.ParseReviewDecision(tt.give)
+			must.EqOp(t, tt.want, have)
+		})
+	}
 }
 
 //nolint:paralleltest  // mocks HTTP
@@ -168,3 +325,104 @@ func TestNewGiteaConnector(t *testing.T) {
 	// 	must.NoError(t, err)
 	// })
 }
+
+func TestUpdateProposalTarget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("supported Gitea version", func(t *testing.T) {
+		t.Parallel()
+		server := newGiteaServer(t, "1.21.0", http.StatusCreated)
+		connector := newTestConnector(t, server)
+		err := connector.UpdateProposalTarget(3, gitdomain.NewLocalBranchName("new-target"))
+		must.NoError(t, err)
+		must.Eq(t, []editRequest{{Base: "new-target", Body: "existing body", Title: "existing title"}}, server.recordedEdits())
+	})
+
+	t.Run("unsupported Gitea version", func(t *testing.T) {
+		t.Parallel()
+		server := newGiteaServer(t, "1.11.0", http.StatusCreated)
+		connector := newTestConnector(t, server)
+		err := connector.UpdateProposalTarget(3, gitdomain.NewLocalBranchName("new-target"))
+		must.ErrorContains(t, err, "this requires Gitea >= 1.12.0")
+		must.SliceEmpty(t, server.recordedEdits())
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		t.Parallel()
+		server := newGiteaServer(t, "1.21.0", http.StatusInternalServerError)
+		connector := newTestConnector(t, server)
+		err := connector.UpdateProposalTarget(3, gitdomain.NewLocalBranchName("new-target"))
+		must.Error(t, err)
+	})
+}
+
+// editRequest is the relevant content of a request to edit a pull request.
+type editRequest struct {
+	Base  string `json:"base"`
+	Body  string `json:"body"`
+	Title string `json:"title"`
+}
+
+// giteaServer is a fake Gitea API server that serves pull request 3 and records the edits made to it.
+type giteaServer struct {
+	*httptest.Server
+	edits []editRequest
+	mutex sync.Mutex
+}
+
+func (self *giteaServer) recordedEdits() []editRequest {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.edits
+}
+
+// newGiteaServer provides a fake Gitea API server with the given version that answers edit requests with the given status code.
+func newGiteaServer(t *testing.T, version string, editStatus int) *giteaServer {
+	t.Helper()
+	result := &giteaServer{Server: nil, edits: []editRequest{}, mutex: sync.Mutex{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, map[string]string{"version": version})
+	})
+	mux.HandleFunc("/api/v1/repos/org/repo/pulls/3", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(t, w, http.StatusOK, map[string]any{"number": 3, "title": "existing title", "body": "existing body"})
+		case http.MethodPatch:
+			var edit editRequest
+			must.NoError(t, json.NewDecoder(r.Body).Decode(&edit))
+			result.mutex.Lock()
+			result.edits = append(result.edits, edit)
+			result.mutex.Unlock()
+			writeJSON(t, w, editStatus, map[string]any{"number": 3})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	result.Server = httptest.NewTLSServer(mux)
+	t.Cleanup(result.Close)
+	return result
+}
+
+// newTestConnector provides a Gitea connector that talks to the given fake server.
+func newTestConnector(t *testing.T, server *giteaServer) *gitea.Connector {
+	t.Helper()
+	serverURL, err := url.Parse(server.URL)
+	must.NoError(t, err)
+	connector, err := gitea.NewConnector(gitea.NewConnectorArgs{
+		APIToken:        "",
+		HTTPClient:      server.Client(),
+		HostingPlatform: configdomain.HostingPlatformGitea,
+		Log:             print.Logger{},
+		OriginURL:       &giturl.Parts{User: "", Host: serverURL.Host, Org: "org", Repo: "repo"},
+	})
+	must.NoError(t, err)
+	return connector
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, data any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	must.NoError(t, json.NewEncoder(w).Encode(data))
+}
@@ -27,6 +27,24 @@ type Connector struct {
 	log        print.Logger
 }
 
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGithubCreatePRViaAPI, branch, parentBranch)
+	pullRequest, _, err := self.client.PullRequests.Create(context.Background(), self.Organization, self.Repository, &github.NewPullRequest{ //nolint:exhaustruct
+		Base:  github.String(parentBranch.String()),
+		Body:  github.String(body),
+		Draft: github.Bool(draft),
+		Head:  github.String(branch.String()),
+		Title: github.String(title),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := parsePullRequest(pullRequest)
+	return &proposal, nil
+}
+
 func (self *Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
@@ -46,10 +64,31 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	if len(pullRequests) > 1 {
 		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(pullRequests), branch, target)
 	}
-	proposal := parsePullRequest(pullRequests[0])
+	pullRequest := pullRequests[0]
+	proposal := parsePullRequest(pullRequest)
+	self.addReviewsAndChecks(&proposal, pullRequest)
 	return &proposal, nil
 }
 
+// addReviewsAndChecks adds the review decision and checks status to the given proposal.
+// This information is optional, API tokens without access to it leave it empty.
+func (self *Connector) addReviewsAndChecks(proposal *hostingdomain.Proposal, pullRequest *github.PullRequest) {
+	reviews, _, err := self.client.PullRequests.ListReviews(context.Background(), self.Organization, self.Repository, pullRequest.GetNumber(), &github.ListOptions{PerPage: 100})
+	if err == nil {
+		proposal.ReviewDecision = ParseReviewDecision(reviews, len(pullRequest.RequestedReviewers)+len(pullRequest.RequestedTeams))
+	}
+	headSHA := pullRequest.GetHead().GetSHA()
+	combinedStatus, _, err := self.client.Repositories.GetCombinedStatus(context.Background(), self.Organization, self.Repository, headSHA, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return
+	}
+	checkRuns, _, err := self.client.Checks.ListCheckRunsForRef(context.Background(), self.Organization, self.Repository, headSHA, &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}})
+	if err != nil {
+		return
+	}
+	proposal.Checks = ParseChecksStatus(combinedStatus, checkRuns.CheckRuns)
+}
+
 func (self *Connector) NewProposalURL(branch, parentBranch gitdomain.LocalBranchName) (string, error) {
 	toCompare := branch.String()
 	if parentBranch != self.MainBranch {
@@ -76,6 +115,19 @@ func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitM
 	return err
 }
 
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGithubUpdateBodyViaAPI, number)
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		Body: &body,
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	self.log.Start(messages.HostingGithubUpdatePRViaAPI, number)
 	targetName := target.String()
@@ -134,12 +186,84 @@ type NewConnectorArgs struct {
 	OriginURL       *giturl.Parts
 }
 
+// ParseChecksStatus combines the legacy commit statuses and the check runs of a commit
+// into the standardized status of the CI checks of a proposal.
+func ParseChecksStatus(combinedStatus *github.CombinedStatus, checkRuns []*github.CheckRun) hostingdomain.ChecksStatus {
+	pending := false
+	passing := false
+	// GitHub reports the combined status as "pending" when there are no commit statuses at all
+	if combinedStatus.GetTotalCount() > 0 {
+		switch combinedStatus.GetState() {
+		case "error", "failure":
+			return hostingdomain.ChecksStatusFailing
+		case "pending":
+			pending = true
+		case "success":
+			passing = true
+		}
+	}
+	for _, checkRun := range checkRuns {
+		if checkRun.GetStatus() != "completed" {
+			pending = true
+			continue
+		}
+		switch checkRun.GetConclusion() {
+		case "action_required", "cancelled", "failure", "timed_out":
+			return hostingdomain.ChecksStatusFailing
+		}
+		passing = true
+	}
+	switch {
+	case pending:
+		return hostingdomain.ChecksStatusPending
+	case passing:
+		return hostingdomain.ChecksStatusPassing
+	}
+	return hostingdomain.ChecksStatusNone
+}
+
+// ParseReviewDecision determines the review decision of a pull request
+// from its reviews in chronological order and the number of reviewers that haven't responded yet.
+func ParseReviewDecision(reviews []*github.PullRequestReview, pendingReviewRequests int) hostingdomain.ReviewDecision {
+	// only the most recent review of each reviewer counts
+	latestReviews := map[string]string{}
+	for _, review := range reviews {
+		state := review.GetState()
+		switch state {
+		case "APPROVED", "CHANGES_REQUESTED":
+			latestReviews[review.GetUser().GetLogin()] = state
+		case "DISMISSED":
+			delete(latestReviews, review.GetUser().GetLogin())
+		}
+	}
+	approved := false
+	for _, state := range latestReviews {
+		if state == "CHANGES_REQUESTED" {
+			return hostingdomain.ReviewDecisionChangesRequested
+		}
+		approved = true
+	}
+	switch {
+	case approved:
+		return hostingdomain.ReviewDecisionApproved
+	case pendingReviewRequests > 0:
+		return hostingdomain.ReviewDecisionReviewRequired
+	}
+	return hostingdomain.ReviewDecisionNone
+}
+
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
-		Number:       pullRequest.GetNumber(),
-		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
-		Title:        pullRequest.GetTitle(),
-		MergeWithAPI: pullRequest.GetMergeableState() == "clean",
+		Author:         pullRequest.GetUser().GetLogin(),
+		Body:           pullRequest.GetBody(),
+		Checks:         hostingdomain.ChecksStatusNone,
+		Draft:          pullRequest.GetDraft(),
+		MergeWithAPI:   pullRequest.GetMergeableState() == "clean",
+		Number:         pullRequest.GetNumber(),
+		ReviewDecision: hostingdomain.ReviewDecisionNone,
+		Target:         gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
+		Title:          pullRequest.GetTitle(),
+		URL:            pullRequest.GetHTMLURL(),
 	}
 }
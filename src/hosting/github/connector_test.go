@@ -9,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v13/src/git/giturl"
 	"github.com/git-town/git-town/v13/src/hosting/github"
 	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	githubsdk "github.com/google/go-github/v58/github"
 	"github.com/shoenig/test/must"
 )
 
@@ -122,3 +123,104 @@ func TestNewConnector(t *testing.T) {
 		must.EqOp(t, wantConfig, have.Config)
 	})
 }
+
+func TestParseChecksStatus(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		combinedStatus *githubsdk.CombinedStatus
+		checkRuns      []*githubsdk.CheckRun
+		want           hostingdomain.ChecksStatus
+	}{
+		"no checks": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("pending"), TotalCount: githubsdk.Int(0)},
+			checkRuns:      []*githubsdk.CheckRun{},
+			want:           hostingdomain.ChecksStatusNone,
+		},
+		"successful commit status": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("success"), TotalCount: githubsdk.Int(1)},
+			checkRuns:      []*githubsdk.CheckRun{},
+			want:           hostingdomain.ChecksStatusPassing,
+		},
+		"failed commit status": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("failure"), TotalCount: githubsdk.Int(2)},
+			checkRuns:      []*githubsdk.CheckRun{{Status: githubsdk.String("completed"), Conclusion: githubsdk.String("success")}},
+			want:           hostingdomain.ChecksStatusFailing,
+		},
+		"running check run": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("success"), TotalCount: githubsdk.Int(1)},
+			checkRuns:      []*githubsdk.CheckRun{{Status: githubsdk.String("in_progress")}},
+			want:           hostingdomain.ChecksStatusPending,
+		},
+		"failed check run": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("pending"), TotalCount: githubsdk.Int(0)},
+			checkRuns: []*githubsdk.CheckRun{
+				{Status: githubsdk.String("in_progress")},
+				{Status: githubsdk.String("completed"), Conclusion: githubsdk.String("timed_out")},
+			},
+			want: hostingdomain.ChecksStatusFailing,
+		},
+		"skipped check run": {
+			combinedStatus: &githubsdk.CombinedStatus{State: githubsdk.String("pending"), TotalCount: githubsdk.Int(0)},
+			checkRuns:      []*githubsdk.CheckRun{{Status: githubsdk.String("completed"), Conclusion: githubsdk.String("skipped")}},
+			want:           hostingdomain.ChecksStatusPassing,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := github.ParseChecksStatus(tt.combinedStatus, tt.checkRuns)
+			must.EqOp(t, tt.want, have)
+		})
+	}
+}
+
+func TestParseReviewDecision(t *testing.T) {
+	t.Parallel()
+	review := func(user, state string) *githubsdk.PullRequestReview {
+		return &githubsdk.PullRequestReview{ //nolint:exhaustruct
+			State: githubsdk.String(state),
+			User:  &githubsdk.User{Login: githubsdk.String(user)}, //nolint:exhaustruct
+		}
+	}
+	tests := map[string]struct {
+		reviews               []*githubsdk.PullRequestReview
+		pendingReviewRequests int
+		want                  hostingdomain.ReviewDecision
+	}{
+		"no reviews": {
+			reviews:               []*githubsdk.PullRequestReview{},
+			pendingReviewRequests: 0,
+			want:                  hostingdomain.ReviewDecisionNone,
+		},
+		"only comments": {
+			reviews:               []*githubsdk.PullRequestReview{review("alice", "COMMENTED")},
+			pendingReviewRequests: 1,
+			want:                  hostingdomain.ReviewDecisionReviewRequired,
+		},
+		"approved": {
+			reviews:               []*githubsdk.PullRequestReview{review("alice", "APPROVED"), review("bob", "COMMENTED")},
+			pendingReviewRequests: 0,
+			want:                  hostingdomain.ReviewDecisionApproved,
+		},
+		"changes requested": {
+			reviews:               []*githubsdk.PullRequestReview{review("alice", "APPROVED"), review("bob", "CHANGES_REQUESTED")},
+			pendingReviewRequests: 0,
+			want:                  hostingdomain.ReviewDecisionChangesRequested,
+		},
+		"approved after requesting changes": {
+			reviews:               []*githubsdk.PullRequestReview{review("alice", "CHANGES_REQUESTED"), review("alice", "APPROVED")},
+			pendingReviewRequests: 0,
+			want:                  hostingdomain.ReviewDecisionApproved,
+		},
+		"dismissed change request": {
+			reviews:               []*githubsdk.PullRequestReview{review("alice", "CHANGES_REQUESTED"), review("alice", "DISMISSED")},
+			pendingReviewRequests: 0,
+			want:                  hostingdomain.ReviewDecisionNone,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := github.ParseReviewDecision(tt.reviews, tt.pendingReviewRequests)
+			must.EqOp(t, tt.want, have)
+		})
+	}
+}
@@ -22,11 +22,34 @@ type Connector struct {
 	log print.Logger
 }
 
+func (self *Connector) CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*hostingdomain.Proposal, error) {
+	self.log.Start(messages.HostingGitlabCreateMRViaAPI, branch, parentBranch)
+	if draft {
+		// GitLab marks merge requests as drafts via their title
+		title = "Draft: " + title
+	}
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.projectPath(), &gitlab.CreateMergeRequestOptions{
+		Description:  gitlab.Ptr(body),
+		SourceBranch: gitlab.Ptr(branch.String()),
+		TargetBranch: gitlab.Ptr(parentBranch.String()),
+		Title:        gitlab.Ptr(title),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return nil, err
+	}
+	self.log.Success()
+	proposal := ParseMergeRequest(mergeRequest)
+	return &proposal, nil
+}
+
 func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
 	opts := &gitlab.ListProjectMergeRequestsOptions{
 		State:        gitlab.Ptr("opened"),
 		SourceBranch: gitlab.Ptr(branch.String()),
-		TargetBranch: gitlab.Ptr(target.String()),
+	}
+	if !target.IsEmpty() {
+		opts.TargetBranch = gitlab.Ptr(target.String())
 	}
 	mergeRequests, _, err := self.client.MergeRequests.ListProjectMergeRequests(self.projectPath(), opts)
 	if err != nil {
@@ -38,7 +61,13 @@ func (self *Connector) FindProposal(branch, target gitdomain.LocalBranchName) (*
 	if len(mergeRequests) > 1 {
 		return nil, fmt.Errorf(messages.ProposalMultipleFound, len(mergeRequests), branch, target)
 	}
-	proposal := parseMergeRequest(mergeRequests[0])
+	// only the single merge request endpoint provides the pipeline status,
+	// which is optional information
+	mergeRequest, _, err := self.client.MergeRequests.GetMergeRequest(self.projectPath(), mergeRequests[0].IID, nil)
+	if err != nil {
+		mergeRequest = mergeRequests[0]
+	}
+	proposal := ParseMergeRequest(mergeRequest)
 	return &proposal, nil
 }
 
@@ -62,6 +91,19 @@ func (self *Connector) SquashMergeProposal(number int, message gitdomain.CommitM
 	return nil
 }
 
+func (self *Connector) UpdateProposalBody(number int, body string) error {
+	self.log.Start(messages.HostingGitlabUpdateMRBodyViaAPI, number)
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		Description: gitlab.Ptr(body),
+	})
+	if err != nil {
+		self.log.Failed(err)
+		return err
+	}
+	self.log.Success()
+	return nil
+}
+
 func (self *Connector) UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error {
 	self.log.Start(messages.HostingGitlabUpdateMRViaAPI, number, target)
 	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
@@ -107,11 +149,49 @@ type NewConnectorArgs struct {
 	OriginURL       *giturl.Parts
 }
 
-func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
+// ParseMergeRequest extracts standardized proposal data from the given GitLab merge request.
+func ParseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
+	author := ""
+	if mergeRequest.Author != nil {
+		author = mergeRequest.Author.Username
+	}
 	return hostingdomain.Proposal{
-		Number:       mergeRequest.IID,
-		Target:       gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
-		Title:        mergeRequest.Title,
-		MergeWithAPI: true,
+		Author:         author,
+		Body:           mergeRequest.Description,
+		Checks:         parsePipeline(mergeRequest.HeadPipeline),
+		Draft:          mergeRequest.Draft || mergeRequest.WorkInProgress,
+		MergeWithAPI:   true,
+		Number:         mergeRequest.IID,
+		ReviewDecision: parseDetailedMergeStatus(mergeRequest.DetailedMergeStatus),
+		Target:         gitdomain.NewLocalBranchName(mergeRequest.TargetBranch),
+		Title:          mergeRequest.Title,
+		URL:            mergeRequest.WebURL,
+	}
+}
+
+// parseDetailedMergeStatus determines the review decision encoded in the given detailed merge status of a GitLab merge request.
+func parseDetailedMergeStatus(detailedMergeStatus string) hostingdomain.ReviewDecision {
+	switch detailedMergeStatus {
+	case "not_approved":
+		return hostingdomain.ReviewDecisionReviewRequired
+	case "requested_changes":
+		return hostingdomain.ReviewDecisionChangesRequested
+	}
+	return hostingdomain.ReviewDecisionNone
+}
+
+// parsePipeline provides the standardized checks status for the given GitLab pipeline.
+func parsePipeline(pipeline *gitlab.Pipeline) hostingdomain.ChecksStatus {
+	if pipeline == nil {
+		return hostingdomain.ChecksStatusNone
+	}
+	switch pipeline.Status {
+	case "success":
+		return hostingdomain.ChecksStatusPassing
+	case "canceled", "failed":
+		return hostingdomain.ChecksStatusFailing
+	case "created", "manual", "pending", "preparing", "running", "scheduled", "waiting_for_resource":
+		return hostingdomain.ChecksStatusPending
 	}
+	return hostingdomain.ChecksStatusNone
 }
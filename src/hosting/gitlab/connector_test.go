@@ -10,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v13/src/hosting/gitlab"
 	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/shoenig/test/must"
+	gitlabsdk "github.com/xanzy/go-gitlab"
 )
 
 func TestGitlabConnector(t *testing.T) {
@@ -122,3 +123,65 @@ func TestNewGitlabConnector(t *testing.T) {
 		must.EqOp(t, wantConfig, have.Config)
 	})
 }
+
+func TestParseMergeRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all data", func(t *testing.T) {
+		t.Parallel()
+		give := &gitlabsdk.MergeRequest{ //nolint:exhaustruct
+			Author:              &gitlabsdk.BasicUser{Username: "alice"}, //nolint:exhaustruct
+			Description:         "my description",
+			DetailedMergeStatus: "not_approved",
+			Draft:               true,
+			HeadPipeline:        &gitlabsdk.Pipeline{Status: "failed"}, //nolint:exhaustruct
+			IID:                 4,
+			TargetBranch:        "main",
+			Title:               "my title",
+			WebURL:              "https://gitlab.com/org/repo/-/merge_requests/4",
+		}
+		have := gitlab.ParseMergeRequest(give)
+		want := hostingdomain.Proposal{
+			Author:         "alice",
+			Body:           "my description",
+			Checks:         hostingdomain.ChecksStatusFailing,
+			Draft:          true,
+			MergeWithAPI:   true,
+			Number:         4,
+			ReviewDecision: hostingdomain.ReviewDecisionReviewRequired,
+			Target:         gitdomain.NewLocalBranchName("main"),
+			Title:          "my title",
+			URL:            "https://gitlab.com/org/repo/-/merge_requests/4",
+		}
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("checks status", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]hostingdomain.ChecksStatus{
+			"success":  hostingdomain.ChecksStatusPassing,
+			"running":  hostingdomain.ChecksStatusPending,
+			"manual":   hostingdomain.ChecksStatusPending,
+			"canceled": hostingdomain.ChecksStatusFailing,
+			"skipped":  hostingdomain.ChecksStatusNone,
+		}
+		for give, want := range tests {
+			mergeRequest := &gitlabsdk.MergeRequest{HeadPipeline: &gitlabsdk.Pipeline{Status: give}, TargetBranch: "main"} //nolint:exhaustruct
+			have := gitlab.ParseMergeRequest(mergeRequest)
+			must.EqOp(t, want, have.Checks)
+		}
+	})
+
+	t.Run("no pipeline", func(t *testing.T) {
+		t.Parallel()
+		have := gitlab.ParseMergeRequest(&gitlabsdk.MergeRequest{TargetBranch: "main"}) //nolint:exhaustruct
+		must.EqOp(t, hostingdomain.ChecksStatusNone, have.Checks)
+		must.EqOp(t, "", have.Author)
+	})
+
+	t.Run("changes requested", func(t *testing.T) {
+		t.Parallel()
+		have := gitlab.ParseMergeRequest(&gitlabsdk.MergeRequest{DetailedMergeStatus: "requested_changes", TargetBranch: "main"}) //nolint:exhaustruct
+		must.EqOp(t, hostingdomain.ReviewDecisionChangesRequested, have.ReviewDecision)
+	})
+}
@@ -0,0 +1,15 @@
+package hostingdomain
+
+// ChecksStatus describes the combined result of the CI checks that run against a proposal.
+type ChecksStatus string
+
+const (
+	ChecksStatusFailing = ChecksStatus("failing") // at least one check has failed
+	ChecksStatusNone    = ChecksStatus("")        // no checks exist or the hosting platform doesn't report them
+	ChecksStatusPassing = ChecksStatus("passing") // all checks have passed
+	ChecksStatusPending = ChecksStatus("pending") // no check has failed so far but some are still running
+)
+
+func (self ChecksStatus) String() string {
+	return string(self)
+}
@@ -5,11 +5,16 @@ import "github.com/git-town/git-town/v13/src/git/gitdomain"
 // Connector describes the activities that Git Town can perform on code hosting platforms.
 // Individual implementations exist to talk to specific hosting platforms.
 type Connector interface {
+	// CreateProposal creates a proposal to merge the given branch into the given parent branch
+	// and provides the newly created proposal.
+	CreateProposal(branch, parentBranch gitdomain.LocalBranchName, title, body string, draft bool) (*Proposal, error)
+
 	// DefaultProposalMessage provides the text that the form for creating new proposals
 	// on the respective hosting platform is prepopulated with.
 	DefaultProposalMessage(proposal Proposal) string
 
 	// FindProposal provides details about the proposal for the given branch into the given target branch.
+	// An empty target finds the proposal for the given branch into any target branch.
 	// Returns nil if no proposal exists.
 	FindProposal(branch, target gitdomain.LocalBranchName) (*Proposal, error)
 
@@ -24,6 +29,9 @@ type Connector interface {
 	// RepositoryURL provides the URL where the current repository can be found online.
 	RepositoryURL() string
 
+	// UpdateProposalBody replaces the description of the proposal with the given number.
+	UpdateProposalBody(number int, body string) error
+
 	// UpdateProposalTarget updates the target branch of the given proposal.
 	UpdateProposalTarget(number int, target gitdomain.LocalBranchName) error
 }
@@ -5,15 +5,33 @@ import "github.com/git-town/git-town/v13/src/git/gitdomain"
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// username of the person who opened this proposal
+	Author string
+
+	// textual description of the proposal
+	Body string
+
+	// combined status of the CI checks that run against this proposal
+	Checks ChecksStatus
+
+	// whether this proposal is a draft, i.e. not ready for review yet
+	Draft bool
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
 	// the number used to identify the proposal on the hosting platform
 	Number int
 
+	// what the reviewers of this proposal have decided so far
+	ReviewDecision ReviewDecision
+
 	// name of the target branch ("base") of this proposal
 	Target gitdomain.LocalBranchName
 
 	// textual title of the proposal
 	Title string
+
+	// URL of the web page that displays this proposal
+	URL string
 }
@@ -0,0 +1,15 @@
+package hostingdomain
+
+// ReviewDecision describes what the reviewers of a proposal have decided so far.
+type ReviewDecision string
+
+const (
+	ReviewDecisionApproved         = ReviewDecision("approved")          // the reviewers have approved the proposal
+	ReviewDecisionChangesRequested = ReviewDecision("changes-requested") // at least one reviewer has requested changes
+	ReviewDecisionNone             = ReviewDecision("")                  // no review activity or the hosting platform doesn't report it
+	ReviewDecisionReviewRequired   = ReviewDecision("review-required")   // the proposal needs approval before it can be merged
+)
+
+func (self ReviewDecision) String() string {
+	return string(self)
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git/giturl"
+	"github.com/git-town/git-town/v13/src/hosting/azuredevops"
 	"github.com/git-town/git-town/v13/src/hosting/bitbucket"
+	"github.com/git-town/git-town/v13/src/hosting/external"
 	"github.com/git-town/git-town/v13/src/hosting/gitea"
 	"github.com/git-town/git-town/v13/src/hosting/github"
 	"github.com/git-town/git-town/v13/src/hosting/gitlab"
@@ -13,15 +15,33 @@ import (
 
 // NewConnector provides an instance of the code hosting connector to use based on the given gitConfig.
 func NewConnector(args NewConnectorArgs) (hostingdomain.Connector, error) {
+	if args.HostingConnectorCommand != "" {
+		return external.NewConnector(external.NewConnectorArgs{
+			Command:   args.HostingConnectorCommand,
+			Log:       args.Log,
+			OriginURL: args.OriginURL,
+		})
+	}
 	switch Detect(args.OriginURL, args.HostingPlatform) {
+	case configdomain.HostingPlatformAzureDevOps:
+		return azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:        args.AzureDevOpsToken,
+			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
+			OriginURL:       args.OriginURL,
+		})
 	case configdomain.HostingPlatformBitbucket:
 		return bitbucket.NewConnector(bitbucket.NewConnectorArgs{
+			AppPassword:     args.BitbucketAppPassword,
 			HostingPlatform: args.HostingPlatform,
+			Log:             args.Log,
 			OriginURL:       args.OriginURL,
+			Username:        args.BitbucketUsername,
 		})
 	case configdomain.HostingPlatformGitea:
 		return gitea.NewConnector(gitea.NewConnectorArgs{
 			APIToken:        args.GiteaToken,
+			HTTPClient:      nil,
 			HostingPlatform: args.HostingPlatform,
 			Log:             args.Log,
 			OriginURL:       args.OriginURL,
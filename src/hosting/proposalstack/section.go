@@ -0,0 +1,131 @@
+// Package proposalstack renders the section of proposal bodies
+// that shows where a proposal sits in its stack of branches.
+package proposalstack
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+)
+
+const (
+	// DefaultTemplate is the template used if the user hasn't configured git-town.proposal-stack-template.
+	DefaultTemplate = `This proposal is part of a stack:
+{{range .Entries}}
+{{.Indent}}- {{if .Number}}[#{{.Number}}]({{.URL}}) {{end}}{{.Branch}}{{if .Current}} ⬅ this proposal{{end}}
+{{- end}}`
+
+	// EndMarker marks the end of the stack section in proposal bodies.
+	EndMarker = "<!-- git-town stack end -->"
+
+	// StartMarker marks the beginning of the stack section in proposal bodies.
+	StartMarker = "<!-- git-town stack start -->"
+)
+
+// Entry describes a branch listed in the stack section.
+type Entry struct {
+	// the name of the branch
+	Branch gitdomain.LocalBranchName
+
+	// whether this is the branch of the proposal that contains the section
+	Current bool
+
+	// how many ancestors of this branch the section lists
+	Depth int
+
+	// the number of the proposal for this branch, 0 if it has none
+	Number int
+
+	// the URL of the proposal for this branch, empty if it has none
+	URL string
+}
+
+// Indent provides two spaces per level of nesting of this entry.
+func (self Entry) Indent() string {
+	return strings.Repeat("  ", self.Depth)
+}
+
+// Branches provides the stack that the given branch is in:
+// its ancestors, the branch itself, and its descendants, ordered hierarchically.
+func Branches(branch gitdomain.LocalBranchName, lineage configdomain.Lineage) gitdomain.LocalBranchNames {
+	return appendDescendants(lineage.BranchAndAncestors(branch), branch, lineage)
+}
+
+// Entries provides the entries of the stack section for the proposal of the given branch.
+func Entries(branch gitdomain.LocalBranchName, lineage configdomain.Lineage, proposals map[gitdomain.LocalBranchName]*hostingdomain.Proposal) []Entry {
+	branches := Branches(branch, lineage)
+	result := make([]Entry, len(branches))
+	for b, stackBranch := range branches {
+		entry := Entry{
+			Branch:  stackBranch,
+			Current: stackBranch == branch,
+			Depth:   len(lineage.Ancestors(stackBranch)),
+			Number:  0,
+			URL:     "",
+		}
+		if proposal, has := proposals[stackBranch]; has && proposal != nil {
+			entry.Number = proposal.Number
+			entry.URL = proposal.URL
+		}
+		result[b] = entry
+	}
+	return result
+}
+
+// HasSection indicates whether the given proposal body contains a stack section.
+func HasSection(body string) bool {
+	start := strings.Index(body, StartMarker)
+	return start >= 0 && strings.Contains(body[start:], EndMarker)
+}
+
+// IsStacked indicates whether the given branch is part of a stack,
+// i.e. whether a stack section in its proposal would list other proposals.
+// Branches without a parent have no proposal and therefore aren't part of a stack.
+func IsStacked(branch gitdomain.LocalBranchName, lineage configdomain.Lineage) bool {
+	ancestorCount := len(lineage.Ancestors(branch))
+	return ancestorCount > 1 || (ancestorCount == 1 && len(lineage.Children(branch)) > 0)
+}
+
+// Render provides the stack section for the given entries, using the given template.
+func Render(templateText configdomain.ProposalStackTemplate, entries []Entry) (string, error) {
+	text := templateText.String()
+	if text == "" {
+		text = DefaultTemplate
+	}
+	parsed, err := template.New("proposal-stack").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var content strings.Builder
+	err = parsed.Execute(&content, struct{ Entries []Entry }{entries})
+	if err != nil {
+		return "", err
+	}
+	return StartMarker + "\n" + strings.TrimSpace(content.String()) + "\n" + EndMarker, nil
+}
+
+// UpdateBody provides the given proposal body with its stack section replaced by the given one.
+// Appends the section if the body doesn't contain one yet.
+func UpdateBody(body, section string) string {
+	if !HasSection(body) {
+		if strings.TrimSpace(body) == "" {
+			return section
+		}
+		return strings.TrimRight(body, "\n") + "\n\n" + section
+	}
+	start := strings.Index(body, StartMarker)
+	end := start + strings.Index(body[start:], EndMarker) + len(EndMarker)
+	return body[:start] + section + body[end:]
+}
+
+// appendDescendants appends all descendants of the given branch to the given list of branches, depth-first.
+func appendDescendants(result gitdomain.LocalBranchNames, branch gitdomain.LocalBranchName, lineage configdomain.Lineage) gitdomain.LocalBranchNames {
+	for _, child := range lineage.Children(branch) {
+		result = append(result, child)
+		result = appendDescendants(result, child, lineage)
+	}
+	return result
+}
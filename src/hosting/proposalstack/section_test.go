@@ -0,0 +1,106 @@
+package proposalstack_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposalStack(t *testing.T) {
+	t.Parallel()
+	main := gitdomain.NewLocalBranchName("main")
+	one := gitdomain.NewLocalBranchName("one")
+	two := gitdomain.NewLocalBranchName("two")
+	three := gitdomain.NewLocalBranchName("three")
+	other := gitdomain.NewLocalBranchName("other")
+	lineage := configdomain.Lineage{
+		one:   main,
+		two:   one,
+		three: two,
+		other: main,
+	}
+
+	t.Run("Branches", func(t *testing.T) {
+		t.Parallel()
+		have := proposalstack.Branches(two, lineage)
+		want := gitdomain.NewLocalBranchNames("main", "one", "two", "three")
+		must.Eq(t, want, have)
+	})
+
+	t.Run("Entries", func(t *testing.T) {
+		t.Parallel()
+		proposals := map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+			one: {Number: 1, URL: "https://example.com/1"}, //nolint:exhaustruct
+			two: {Number: 2, URL: "https://example.com/2"}, //nolint:exhaustruct
+		}
+		have := proposalstack.Entries(two, lineage, proposals)
+		want := []proposalstack.Entry{
+			{Branch: main, Current: false, Depth: 0, Number: 0, URL: ""},
+			{Branch: one, Current: false, Depth: 1, Number: 1, URL: "https://example.com/1"},
+			{Branch: two, Current: true, Depth: 2, Number: 2, URL: "https://example.com/2"},
+			{Branch: three, Current: false, Depth: 3, Number: 0, URL: ""},
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("IsStacked", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, proposalstack.IsStacked(one, lineage))
+		must.True(t, proposalstack.IsStacked(three, lineage))
+		must.False(t, proposalstack.IsStacked(other, lineage))
+		must.False(t, proposalstack.IsStacked(main, lineage))
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		t.Parallel()
+		entries := []proposalstack.Entry{
+			{Branch: main, Current: false, Depth: 0, Number: 0, URL: ""},
+			{Branch: one, Current: true, Depth: 1, Number: 1, URL: "https://example.com/1"},
+			{Branch: two, Current: false, Depth: 2, Number: 2, URL: "https://example.com/2"},
+		}
+
+		t.Run("default template", func(t *testing.T) {
+			t.Parallel()
+			have, err := proposalstack.Render("", entries)
+			must.NoError(t, err)
+			want := `<!-- git-town stack start -->
+This proposal is part of a stack:
+
+- main
+  - [#1](https://example.com/1) one ⬅ this proposal
+    - [#2](https://example.com/2) two
+<!-- git-town stack end -->`
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("custom template", func(t *testing.T) {
+			t.Parallel()
+			have, err := proposalstack.Render("{{range .Entries}}{{if .Number}}#{{.Number}} {{end}}{{end}}", entries)
+			must.NoError(t, err)
+			must.EqOp(t, "<!-- git-town stack start -->\n#1 #2\n<!-- git-town stack end -->", have)
+		})
+
+		t.Run("invalid template", func(t *testing.T) {
+			t.Parallel()
+			_, err := proposalstack.Render("{{range .Entries}}", entries)
+			must.Error(t, err)
+		})
+	})
+
+	t.Run("UpdateBody", func(t *testing.T) {
+		t.Parallel()
+		section := proposalstack.StartMarker + "\nnew\n" + proposalstack.EndMarker
+		tests := map[string]string{
+			"":            section,
+			"description": "description\n\n" + section,
+			"description\n\n" + proposalstack.StartMarker + "\nold\n" + proposalstack.EndMarker + "\n\nfooter": "description\n\n" + section + "\n\nfooter",
+		}
+		for give, want := range tests {
+			must.EqOp(t, want, proposalstack.UpdateBody(give, section))
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package proposalstack
+
+import (
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/gohacks/slice"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+)
+
+// Stacks provides all branches in the stacks that the given branches are in,
+// i.e. the trees of branches below the topmost feature branches above the given branches.
+// Branches that aren't part of a stack are omitted.
+func Stacks(branches gitdomain.LocalBranchNames, lineage configdomain.Lineage) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, branch := range branches {
+		if !IsStacked(branch, lineage) {
+			continue
+		}
+		topBranch := lineage.BranchAndAncestors(branch)[1]
+		result = slice.AppendAllMissing(result, Branches(topBranch, lineage)...)
+	}
+	return result
+}
+
+// UpdateStacks refreshes the stack sections in the proposals of the stacks that the given branches are in.
+// The stack sections list all branches of the stack, so this loads the proposals of all of them.
+func UpdateStacks(args UpdateStacksArgs) error {
+	stackBranches := Stacks(args.Branches, args.Lineage)
+	proposals := map[gitdomain.LocalBranchName]*hostingdomain.Proposal{}
+	for _, branch := range stackBranches {
+		parent := args.Lineage.Parent(branch)
+		if parent.IsEmpty() {
+			continue
+		}
+		proposal, err := args.Connector.FindProposal(branch, parent)
+		if err != nil {
+			return err
+		}
+		if proposal != nil {
+			proposals[branch] = proposal
+		}
+	}
+	for _, branch := range stackBranches {
+		proposal, hasProposal := proposals[branch]
+		if !hasProposal || (!args.AddSections && !HasSection(proposal.Body)) {
+			continue
+		}
+		if args.OnlyGivenBranches && !args.Branches.Contains(branch) {
+			continue
+		}
+		section, err := Render(args.Template, Entries(branch, args.Lineage, proposals))
+		if err != nil {
+			return err
+		}
+		body := UpdateBody(proposal.Body, section)
+		if body == proposal.Body {
+			continue
+		}
+		err = args.Connector.UpdateProposalBody(proposal.Number, body)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type UpdateStacksArgs struct {
+	// whether to add a stack section to proposals that don't have one yet
+	AddSections bool
+
+	// the branches whose stacks to update
+	Branches gitdomain.LocalBranchNames
+
+	Connector hostingdomain.Connector
+	Lineage   configdomain.Lineage
+
+	// whether to update only the proposals of the given branches instead of all proposals in their stacks
+	OnlyGivenBranches bool
+
+	Template configdomain.ProposalStackTemplate
+}
@@ -0,0 +1,143 @@
+package proposalstack_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/config/configdomain"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/shoenig/test/must"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	lineage := configdomain.Lineage{
+		gitdomain.NewLocalBranchName("one"):   gitdomain.NewLocalBranchName("main"),
+		gitdomain.NewLocalBranchName("two"):   gitdomain.NewLocalBranchName("one"),
+		gitdomain.NewLocalBranchName("alone"): gitdomain.NewLocalBranchName("main"),
+	}
+
+	t.Run("Stacks", func(t *testing.T) {
+		t.Parallel()
+		have := proposalstack.Stacks(gitdomain.NewLocalBranchNames("main", "two", "alone"), lineage)
+		want := gitdomain.NewLocalBranchNames("main", "one", "two")
+		must.Eq(t, want, have)
+	})
+
+	t.Run("UpdateStacks", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("updates only proposals that have a stack section", func(t *testing.T) {
+			t.Parallel()
+			connector := fakeConnector{
+				bodies: map[int]string{},
+				proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+					gitdomain.NewLocalBranchName("one"): {Body: "one body", Number: 1, URL: "https://example.com/1"},                                                                       //nolint:exhaustruct
+					gitdomain.NewLocalBranchName("two"): {Body: "two body\n\n" + proposalstack.StartMarker + "\nold\n" + proposalstack.EndMarker, Number: 2, URL: "https://example.com/2"}, //nolint:exhaustruct
+				},
+			}
+			err := proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+				AddSections:       false,
+				Branches:          gitdomain.NewLocalBranchNames("two"),
+				Connector:         connector,
+				Lineage:           lineage,
+				OnlyGivenBranches: false,
+				Template:          "{{range .Entries}}{{if .Number}}#{{.Number}} {{end}}{{end}}",
+			})
+			must.NoError(t, err)
+			want := map[int]string{
+				2: "two body\n\n" + proposalstack.StartMarker + "\n#1 #2\n" + proposalstack.EndMarker,
+			}
+			must.Eq(t, want, connector.bodies)
+		})
+
+		t.Run("adds missing stack sections", func(t *testing.T) {
+			t.Parallel()
+			connector := fakeConnector{
+				bodies: map[int]string{},
+				proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+					gitdomain.NewLocalBranchName("one"): {Body: "", Number: 1, URL: "https://example.com/1"}, //nolint:exhaustruct
+				},
+			}
+			err := proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+				AddSections:       true,
+				Branches:          gitdomain.NewLocalBranchNames("one"),
+				Connector:         connector,
+				Lineage:           lineage,
+				OnlyGivenBranches: false,
+				Template:          "{{range .Entries}}{{.Branch}} {{end}}",
+			})
+			must.NoError(t, err)
+			want := map[int]string{
+				1: proposalstack.StartMarker + "\nmain one two\n" + proposalstack.EndMarker,
+			}
+			must.Eq(t, want, connector.bodies)
+		})
+
+		t.Run("updates only the proposals of the given branches", func(t *testing.T) {
+			t.Parallel()
+			connector := fakeConnector{
+				bodies: map[int]string{},
+				proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+					gitdomain.NewLocalBranchName("one"): {Body: proposalstack.StartMarker + "\nold\n" + proposalstack.EndMarker, Number: 1, URL: "https://example.com/1"}, //nolint:exhaustruct
+					gitdomain.NewLocalBranchName("two"): {Body: proposalstack.StartMarker + "\nold\n" + proposalstack.EndMarker, Number: 2, URL: "https://example.com/2"}, //nolint:exhaustruct
+				},
+			}
+			err := proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+				AddSections:       false,
+				Branches:          gitdomain.NewLocalBranchNames("two"),
+				Connector:         connector,
+				Lineage:           lineage,
+				OnlyGivenBranches: true,
+				Template:          "{{range .Entries}}{{if .Number}}#{{.Number}} {{end}}{{end}}",
+			})
+			must.NoError(t, err)
+			want := map[int]string{
+				2: proposalstack.StartMarker + "\n#1 #2\n" + proposalstack.EndMarker,
+			}
+			must.Eq(t, want, connector.bodies)
+		})
+	})
+}
+
+// fakeConnector is a hostingdomain.Connector stand-in that knows the given proposals
+// and records the proposal bodies it is asked to update.
+type fakeConnector struct {
+	bodies    map[int]string
+	proposals map[gitdomain.LocalBranchName]*hostingdomain.Proposal
+}
+
+func (self fakeConnector) CreateProposal(_, _ gitdomain.LocalBranchName, _, _ string, _ bool) (*hostingdomain.Proposal, error) {
+	return nil, errors.New("unexpected call")
+}
+
+func (self fakeConnector) DefaultProposalMessage(_ hostingdomain.Proposal) string {
+	return ""
+}
+
+func (self fakeConnector) FindProposal(branch, _ gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	return self.proposals[branch], nil
+}
+
+func (self fakeConnector) NewProposalURL(_, _ gitdomain.LocalBranchName) (string, error) {
+	return "", nil
+}
+
+func (self fakeConnector) RepositoryURL() string {
+	return ""
+}
+
+func (self fakeConnector) SquashMergeProposal(_ int, _ gitdomain.CommitMessage) error {
+	return errors.New("unexpected call")
+}
+
+func (self fakeConnector) UpdateProposalBody(number int, body string) error {
+	self.bodies[number] = body
+	return nil
+}
+
+func (self fakeConnector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
+	return errors.New("unexpected call")
+}
@@ -2,8 +2,12 @@ package messages
 
 const (
 	UndoContinueGuidance               = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
+	AheadBehindUnexpectedOutput        = "unexpected output when counting the commits of branch and base: %q"
 	AliasedCommands                    = "Aliased commands: %s\n"
 	ArgumentUnknown                    = "unknown argument: %q"
+	AzureDevOpsToken                   = "Azure DevOps token: %s\n"
+	BitbucketAppPassword               = "Bitbucket app password: %s\n"
+	BitbucketUsername                  = "Bitbucket username: %s\n"
 	BranchAlreadyExistsLocally         = "there is already a branch %q"
 	BranchAlreadyExistsRemotely        = "there is already a branch %q at the \"origin\" remote"
 	BranchAuthorMultiple               = "\nMultiple people authored the %q branch.\n\n"
@@ -18,13 +22,30 @@ const (
 	BranchIsAlreadyContribution        = "branch %q is already a contribution branch"
 	BranchIsAlreadyObserved            = "branch %q is already observed"
 	BranchIsAlreadyParked              = "branch %q is already parked"
+	BranchIsAlreadyPrototype           = "branch %q is already a prototype branch"
 	BranchLocalSHAProblem              = "cannot determine SHA of local branch %q: %w"
 	BranchLocalProblem                 = "cannot determine whether the local branch %q exists: %w"
 	BranchParentChanged                = "branch %q is now a child of %q"
+	BranchProposalProblem              = "cannot load the proposal for branch %q: %v"
+	BranchTypeHeader                   = "Branch %q"
+	BranchTypeReasonBranchList         = "listed in the %s branches"
+	BranchTypeReasonDefault            = "no branch type setting or rule applies to this branch"
+	BranchTypeReasonMainBranch         = "configured as the main branch"
+	BranchTypeReasonRegex              = "matches the %s regex"
+	BranchTypeReasonRule               = "matches branch type rule %d: %s"
+	BranchTypeRegexIgnored             = "Ignoring %v\n"
+	BranchTypeRegexInvalid             = "invalid %s regex %q: %w"
+	BranchTypeRuleEmptyPattern         = "the branch type rule for %s branches has an empty pattern"
+	BranchTypeRuleInvalidFormat        = "invalid branch type rule %q, expected the format <branch type>=<pattern>"
+	BranchTypeRuleInvalidPattern       = "invalid branch pattern %q in branch type rule: %w"
+	BranchTypeRuleMainBranch           = "branch type rules cannot define the main branch, please use the main-branch setting for that"
+	BranchTypeUnknown                  = "unknown branch type: %q"
 	BrowserOpen                        = "Please open in a browser: %s\n"
 	CacheUnitialized                   = "using a cached value before initialization"
 	CodeHosting                        = "Code hosting: %s\n"
 	CommandsRun                        = "Ran %d shell commands."
+	CommitCountsProblem                = "cannot determine how many commits %q and %q differ by: %w"
+	CommitCountsUnexpectedOutput       = "unexpected output when counting the commits that %q and %q differ by: %q"
 	CommitMessageProblem               = "cannot determine last commit message: %w"
 	CompressUnsynced                   = "please sync branch %q before compressing it"
 	CompressIsPerennial                = "better not compress perennial branches"
@@ -36,11 +57,15 @@ const (
 	CompletionTypeUnknown              = "unknown completion type: %q"
 	ConfigFileCannotRead               = "cannot read the configuration file %q: %w"
 	ConfigFileInvalidData              = "the configuration file %q does not contain TOML-formatted content: %w"
+	ConfigFileLineageEmptyBranch       = "the lineage in the configuration file contains an empty branch name"
+	ConfigFileLineageSelfParent        = "the lineage in the configuration file makes branch %q its own parent"
+	ConfigFileRulePatternMissing       = "the branch type rule for %s branches in the configuration file must define either \"branches\" or \"regex\""
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
 	ConfigNeeded                       = "Git Town needs to be configured\n\n"
 	ConfigStorage                      = "Config storage: %s\n"
 	ConfigSyncFeatureStrategyUnknown   = "unknown sync-feature strategy: %q"
 	ConfigSyncPerennialStrategyUnknown = "unknown sync-perennial strategy: %q"
+	ConfigSyncStrategyRuleInvalidGlob  = "invalid branch pattern %q in sync-strategy override: %w"
 	ConfigRemoveError                  = "unexpected error while removing the 'git-town' section from the Git configuration: %w"
 	ContinueMessage                    = `You can run "git town continue" to finish it.`
 	ContinueSkipGuidance               = "To continue by skipping the current branch, run \"git town skip\"."
@@ -91,12 +116,38 @@ END OUTPUT FROM 'git branch -vva'
 	HackBranchIsNowFeature                = "branch %q is now a feature branch\n"
 	HackCannotFeatureMainBranch           = "cannot make the main branch a feature branch"
 	HackCannotFeaturePerennialBranch      = "branch %q is a perennial branch and therefore be a feature branch"
-	HostingBitBucketNotImplemented        = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
+	HostingAzureDevOpsAPIProblem          = "Azure DevOps API: %s %s failed with %s: %s"
+	HostingAzureDevOpsCreatePRViaAPI      = "Azure DevOps API: creating PR from %q to %q ... "
+	HostingAzureDevOpsMergingViaAPI       = "Azure DevOps API: completing PR %d ... "
+	HostingAzureDevOpsUpdateBodyViaAPI    = "Azure DevOps API: updating description of PR %d ... "
+	HostingAzureDevOpsUpdatePRViaAPI      = "Azure DevOps API: updating target branch for PR %d to %q ... "
+	HostingBitbucketAPIProblem            = "Bitbucket API: %s %s failed with %s: %s"
+	HostingBitbucketCreatePRViaAPI        = "Bitbucket API: creating PR from %q to %q ... "
+	HostingBitbucketMergingViaAPI         = "Bitbucket API: merging PR #%d ... "
+	HostingBitbucketUpdateBodyViaAPI      = "Bitbucket API: updating description of PR #%d ... "
+	HostingBitbucketUpdatePRViaAPI        = "Bitbucket API: updating target branch for PR #%d to %q ... "
+	HostingExternalCommandEmpty           = "the hosting connector command is empty"
+	HostingExternalCommandFailed          = "hosting connector command %q failed to execute %s: %v\n%s"
+	HostingExternalCommandInvalid         = "invalid hosting connector command %q: %w"
+	HostingExternalCommandInvalidOutput   = "hosting connector command %q provided invalid output for %s: %w"
+	HostingExternalCommandNoProposal      = "hosting connector command %q did not provide the created proposal"
+	HostingExternalCreateViaCommand       = "Hosting connector: creating proposal from %q to %q ... "
+	HostingExternalDefaultMessage         = "Hosting connector: determining the commit message for proposal #%d ... "
+	HostingExternalMergingViaCommand      = "Hosting connector: merging proposal #%d ... "
+	HostingExternalRepositoryURL          = "Hosting connector: determining the repository URL ... "
+	HostingExternalUpdateBodyViaCommand   = "Hosting connector: updating description of proposal #%d ... "
+	HostingExternalUpdatePRViaCommand     = "Hosting connector: updating target branch for proposal #%d to %q ... "
+	HostingGitlabCreateMRViaAPI           = "GitLab API: Creating MR from %q to %q ... "
 	HostingGitlabMergingViaAPI            = "GitLab API: Merging MR !%d ... "
+	HostingGitlabUpdateMRBodyViaAPI       = "GitLab API: Updating description of MR !%d ... "
 	HostingGitlabUpdateMRViaAPI           = "GitLab API: Updating target branch for MR !%d to %q ... "
-	HostingGiteaNotImplemented            = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
-	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to #%s"
+	HostingGiteaCreatePRViaAPI            = "Gitea API: Creating PR from %q to %q ... "
+	HostingGiteaUpdateBodyViaAPI          = "Gitea API: Updating description of PR #%d ... "
+	HostingGiteaUpdatePRUnsupported       = "this Gitea server cannot update the base branch of pull requests, this requires Gitea %s: %w"
+	HostingGiteaUpdatePRViaAPI            = "Gitea API: Updating base branch for PR #%d to %q ... "
+	HostingGithubCreatePRViaAPI           = "GitHub API: creating PR from %q to %q ... "
 	HostingGithubMergingViaAPI            = "GitHub API: merging PR #%d ... "
+	HostingGithubUpdateBodyViaAPI         = "GitHub API: updating description of PR #%d ... "
 	HostingGithubUpdatePRViaAPI           = "GitHub API: updating base branch for PR #%d ... "
 	HostingPlatformUnknown                = "unknown hosting platform: %q"
 	InputAddOrRemove                      = `invalid argument %q. Please provide either "add" or "remove"`
@@ -104,12 +155,24 @@ END OUTPUT FROM 'git branch -vva'
 	KillBranchOtherWorktree               = `branch %q is active in another worktree`
 	KillCannotKillMainBranch              = "you cannot kill the main branch"
 	KillCannotKillPerennialBranches       = "you cannot kill perennial branches"
+	LineageNoOrigin                       = "cannot share the lineage because this repository has no \"origin\" remote"
+	LineagePulledNothing                  = "the shared lineage contains no new parent branches\n"
+	LineagePulledParent                   = "branch %q now has the parent branch %q\n"
+	LineagePushRetry                      = "cannot upload the shared lineage because it has changed at origin, merging the new version and trying again\n"
+	LineagePushed                         = "shared the parent branches of %d branches\n"
 	MainBranch                            = "Main branch: %s\n"
 	MainBranchCannotMakeContribution      = "cannot make the main branch a contribution branch"
 	MainBranchCannotObserve               = "cannot observe the main branch"
 	MainBranchCannotPark                  = "cannot park the main branch"
 	MainBranchCannotPropose               = "cannot propose the main branch"
+	MainBranchCannotPrototype             = "cannot make the main branch a prototype branch"
 	MainBranchCannotShip                  = "cannot ship the main branch"
+	MoveDirectionMissing                  = "please provide either --up or --down"
+	MoveDownMultipleChildren              = "cannot move branch %q down because it has several child branches"
+	MoveDownNoChild                       = "cannot move branch %q down because it has no child branches"
+	MoveUnsynced                          = "please sync branch %q before moving it"
+	MoveUpParentMultipleChildren          = "cannot move branch %q up because its parent %q has several child branches"
+	MoveUpParentNotFeature                = "cannot move branch %q up because its parent %q is not a feature branch"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
@@ -119,22 +182,30 @@ END OUTPUT FROM 'git branch -vva'
 	OpenChangesProblem                    = "cannot determine open changes: %w"
 	OriginHostname                        = "Origin hostname: %s\n"
 	ParentDialogSelected                  = "Selected parent branch for %q: %s\n"
+	ParentInferred                        = "Inferred parent branch for %q: %s\n"
 	ParkedBranchIsNowParked               = "branch %q is now parked\n"
 	PerennialBranchCannotMakeContribution = "cannot make perennial branches contribution branches"
 	PerennialBranchCannotObserve          = "cannot observe perennial branches"
 	PerennialBranchCannotPark             = "cannot park perennial branches"
 	PerennialBranchCannotPropose          = "cannot propose perennial branches"
+	PerennialBranchCannotPrototype        = "cannot make perennial branches prototype branches"
 	PerennialBranchCannotShip             = "cannot ship perennial branches"
 	PerennialBranches                     = "Perennial branches: %s\n"
 	PerennialBranchRemovedParentEntry     = "Removed parent entry for perennial branch %q\n"
 	PerennialRegex                        = "Perennial regex: %s\n"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
 	PreviousCommandProblem                = "The last Git Town command (%s) hit a problem %v ago.\n"
+	ProposalCreated                       = "Created proposal: %s"
 	ProposalMultipleFound                 = "found %d proposals from branch %q to branch %q"
 	ProposalNoNumberGiven                 = "no proposal number given"
+	ProposalStackNoStack                  = "branch %q is not part of a stack"
+	ProposalStackUpdateProblem            = "cannot update the stack sections of proposals: %v"
 	ProposalNotFoundForBranch             = "cannot determine proposal for branch %q: %w"
 	ProposalTargetBranchUpdateProblem     = "cannot update the target branch of proposal %d via the API"
+	ProposalTargetNoConnector             = "cannot update the target branch of proposal %d to %q because no hosting connector is available"
 	ProposalURLProblem                    = "cannot determine proposal URL from %q to %q: %w"
+	PrototypeBranchCannotPropose          = "cannot propose branch %q because branch type rule %q makes it a prototype branch"
+	PrototypeBranchIsNowPrototype         = "branch %q is now a prototype branch\n"
 	PullRequestDeprecation                = `DEPRECATION NOTICE
 
 This command has been renamed to "git town propose"
@@ -149,6 +220,7 @@ nd will be removed in future versions of Git Town.`
 	RenamePerennialBranchWarning   = "%q is a perennial branch. Renaming a perennial branch typically requires other updates. If you are sure you want to do this, use '--force'"
 	RenameToSameName               = "cannot rename branch to current name"
 	RepoOutside                    = "this is not a Git repository"
+	RepoSnapshotTimings            = "\nLoaded the repo snapshot in %s (local state %s, fetch %s, branches %s).\n"
 	RunAutoUndo                    = "%s\nAuto-undo... "
 	RunCommandProblem              = "error running command %q: %w"
 	RunstateDeleted                = "Runstate file deleted."
@@ -169,36 +241,58 @@ I am upgrading this setting to the new format %q.
 I found the deprecated local setting %q.
 I am upgrading this setting to the new format %q.
 `
-	SettingLocalCannotRemove    = "ERROR: cannot remove local Git setting %q: %v"
-	SettingLocalCannotWrite     = "ERROR: cannot write local Git setting %q: %v"
-	ShipAbortedMergeError       = "aborted because commit exited with error"
-	ShipBranchOtherWorktree     = "branch %q is active in another worktree"
-	ShipBranchNothingToDo       = "the branch %q has no shippable changes"
-	ShipChildBranch             = "shipping this branch would ship %s as well,\nplease ship %q first"
-	ShipDeletesTrackingBranches = "Ship deletes tracking branches: %s\n"
-	ShipOpenChanges             = "you have uncommitted changes. Did you mean to commit them before shipping?"
-	ShippableChangesProblem     = "cannot determine whether branch %q has shippable changes: %w"
-	SkipBranchHasConflicts      = "cannot skip branch that resulted in conflicts"
-	SkipMessage                 = `You can run "git town skip" to skip the currently failing operation.`
-	SkipNothingToDo             = "nothing to skip"
-	SquashCannotReadFile        = "cannot read squash message file %q: %w"
-	SquashCommitAuthorQuery     = "Please choose an author for the squash commit:"
-	SquashCommitAuthorProblem   = "error getting squash commit author: %w"
-	SquashCommitAuthorSelection = "Selected squash commit author: %s\n"
-	SquashMessageProblem        = "cannot comment out the squash commit message: %w"
-	StatusFileNotFound          = "No status file found for this repository."
-	SyncBeforeShip              = "Sync before ship: %s\n"
-	SyncFeatureBranches         = "Sync feature branches: %s\n"
-	SyncPerennialBranches       = "Sync perennial branches: %s\n"
-	SyncStatusNotRecognized     = "cannot determine the sync status for Git remote %q and branch name %q"
-	SyncWithUpstream            = "Sync with upstream: %s\n"
-	UndoCreateOpcodeProblem     = "cannot create undo operations for %q: %w"
-	UndoMessage                 = `You can run "git town undo" to go back to where you started.`
-	UndoNothingToDo             = "nothing to undo"
-	UnfinishedCommandHandle     = "Handle unfinished command: %s\n"
-	UnfinishedRunStateContinue  = "Continue the \"%s\" command after having resolved conflicts"
-	UnfinishedRunStateDiscard   = "Discard the unfinished state and run the new command"
-	UnfinishedRunStateQuit      = "Quit without running anything"
-	UnfinishedRunStateSkip      = "Skip the current branch and continue the \"%s\" command on the next branch"
-	UnfinishedRunStateUndo      = "Undo the previous \"%s\" command"
+	SettingLocalCannotRemove       = "ERROR: cannot remove local Git setting %q: %v"
+	SettingLocalCannotWrite        = "ERROR: cannot write local Git setting %q: %v"
+	ShipAbortedMergeError          = "aborted because commit exited with error"
+	ShipBranchOtherWorktree        = "branch %q is active in another worktree"
+	ShipBranchNothingToDo          = "the branch %q has no shippable changes"
+	ShipChildBranch                = "shipping this branch would ship %s as well,\nplease ship %q first"
+	ShipDeletesTrackingBranches    = "Ship deletes tracking branches: %s\n"
+	ShipOpenChanges                = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShipProposalChecksFailing      = "cannot ship branch %q because the CI checks of proposal #%d are failing"
+	ShipProposalDraft              = "cannot ship branch %q because proposal #%d is still a draft"
+	ShipProposalNeedsChanges       = "cannot ship branch %q because the reviewers of proposal #%d have requested changes"
+	ShipProposalNotApproved        = "cannot ship branch %q because proposal #%d has not been approved yet"
+	ShippableChangesProblem        = "cannot determine whether branch %q has shippable changes: %w"
+	SkipBranchHasConflicts         = "cannot skip branch that resulted in conflicts"
+	SkipMessage                    = `You can run "git town skip" to skip the currently failing operation.`
+	SkipNothingToDo                = "nothing to skip"
+	SplitCommitSelected            = "Selected last commit of the new branch: %s\n"
+	SplitContributionBranch        = "you are merely contributing to branch %q and should leave splitting it to the branch owner"
+	SplitIsPerennial               = "cannot split perennial branches"
+	SplitNotEnoughCommits          = "branch %q needs at least two commits to split it"
+	SplitObservedBranch            = "you are merely observing branch %q and should leave splitting it to the branch owner"
+	SplitUnsynced                  = "please sync branch %q before splitting it"
+	SquashCannotReadFile           = "cannot read squash message file %q: %w"
+	SquashCommitAuthorQuery        = "Please choose an author for the squash commit:"
+	SquashCommitAuthorProblem      = "error getting squash commit author: %w"
+	SquashCommitAuthorSelection    = "Selected squash commit author: %s\n"
+	SquashMessageProblem           = "cannot comment out the squash commit message: %w"
+	StatusFileNotFound             = "No status file found for this repository."
+	SwitchPreviewCommits           = "Commits in %s that aren't in %s:"
+	SwitchPreviewNoCommits         = "No commits in %s that aren't in %s."
+	SwitchPreviewProblem           = "cannot load the commits of branch %q: %v"
+	SyncAllAndStack                = "the --all and --stack flags cannot be used together"
+	SyncBeforeShip                 = "Sync before ship: %s\n"
+	SyncFeatureBranches            = "Sync feature branches: %s\n"
+	SyncPerennialBranches          = "Sync perennial branches: %s\n"
+	SyncStatusNotRecognized        = "cannot determine the sync status for Git remote %q and branch name %q"
+	SyncWithUpstream               = "Sync with upstream: %s\n"
+	SyncWorktreeOpenChanges        = "did not sync branch %q because its worktree at %q has uncommitted changes"
+	TrackingCountsUnexpectedOutput = "unexpected output when counting the commits of branch and tracking branch: %q"
+	UndoCreateOpcodeProblem        = "cannot create undo operations for %q: %w"
+	UndoMessage                    = `You can run "git town undo" to go back to where you started.`
+	UndoNothingToDo                = "nothing to undo"
+	UndoStepsInvalid               = "the number of commands to undo must be at least 1"
+	UndoStepsTooMany               = "cannot undo %d commands because the undo history contains only %d"
+	UndoStepsUnsafe                = "cannot undo %d commands because the branches changed between them, only the last %d commands can be undone"
+	UndoWorktreeOpenChanges        = "cannot undo the changes to branch %q because its worktree at %q has uncommitted changes"
+	UnfinishedCommandHandle        = "Handle unfinished command: %s\n"
+	UnfinishedRunStateContinue     = "Continue the \"%s\" command after having resolved conflicts"
+	UnfinishedRunStateDiscard      = "Discard the unfinished state and run the new command"
+	UnfinishedRunStateQuit         = "Quit without running anything"
+	UnfinishedRunStateSkip         = "Skip the current branch and continue the \"%s\" command on the next branch"
+	UnfinishedRunStateUndo         = "Undo the previous \"%s\" command"
+	WorktreeNotFound               = "branch %q is not checked out in any worktree"
+	WorktreesProblem               = "cannot determine the worktrees: %w"
 )
@@ -15,7 +15,7 @@ import (
 
 // executes the "skip" command at the given runstate
 func Execute(args ExecuteArgs) error {
-	lightInterpreter.Execute(args.RunState.AbortProgram, args.Runner, args.Runner.Config.FullConfig.Lineage)
+	lightInterpreter.Execute(args.RunState.AbortProgram, args.Runner, args.Connector, args.Runner.Config.FullConfig.Lineage, args.RootDir)
 	revertChangesToCurrentBranch(args)
 	args.RunState.RunProgram = removeOpcodesForCurrentBranch(args.RunState.RunProgram)
 	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
@@ -71,7 +71,8 @@ func revertChangesToCurrentBranch(args ExecuteArgs) {
 		BeginBranch:              args.CurrentBranch,
 		Config:                   &args.Runner.Config.FullConfig,
 		EndBranch:                args.CurrentBranch,
+		OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 		UndoablePerennialCommits: args.RunState.UndoablePerennialCommits,
 	})
-	lightInterpreter.Execute(undoCurrentBranchProgram, args.Runner, args.Runner.Config.FullConfig.Lineage)
+	lightInterpreter.Execute(undoCurrentBranchProgram, args.Runner, args.Connector, args.Runner.Config.FullConfig.Lineage, args.RootDir)
 }
@@ -2,6 +2,7 @@ package subshell
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -19,6 +20,9 @@ type BackendRunner struct {
 	// If set, runs the commands in the given directory.
 	// If not set, runs the commands in the current working directory.
 	Dir *string
+	// If set, receives the verbose output instead of STDOUT.
+	// This allows printing the output of concurrently executed commands in a deterministic order.
+	Output io.Writer
 	// whether to print the executed commands to the CLI
 	Verbose bool
 }
@@ -53,8 +57,12 @@ func (self BackendRunner) RunMany(commands [][]string) error {
 
 func (self BackendRunner) execute(executable string, args ...string) ([]byte, error) {
 	self.CommandsCounter.Register()
+	output := self.Output
+	if output == nil {
+		output = os.Stdout
+	}
 	if self.Verbose {
-		printHeader(executable, args...)
+		printHeader(output, executable, args...)
 	}
 	subProcess := exec.Command(executable, args...) // #nosec
 	if self.Dir != nil {
@@ -66,7 +74,7 @@ func (self BackendRunner) execute(executable string, args ...string) ([]byte, er
 		err = ErrorDetails(executable, args, err, outputBytes)
 	}
 	if self.Verbose && len(outputBytes) > 0 {
-		os.Stdout.Write(outputBytes)
+		_, _ = output.Write(outputBytes)
 	}
 	return outputBytes, err
 }
@@ -84,8 +92,8 @@ OUTPUT END
 ----------------------------------------`, executable, strings.Join(args, " "), err, string(output))
 }
 
-func printHeader(cmd string, args ...string) {
+func printHeader(output io.Writer, cmd string, args ...string) {
 	quoted := stringslice.SurroundEmptyWith(args, `"`)
 	text := "\n(verbose) " + cmd + " " + strings.Join(quoted, " ")
-	fmt.Println(print.Bold.Styled(text))
+	fmt.Fprintln(output, print.Bold.Styled(text))
 }
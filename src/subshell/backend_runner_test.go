@@ -5,8 +5,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/acarl005/stripansi"
 	"github.com/git-town/git-town/v13/src/gohacks"
 	"github.com/git-town/git-town/v13/src/subshell"
 	"github.com/shoenig/test/must"
@@ -19,7 +21,7 @@ func TestBackendRunner(t *testing.T) {
 		t.Parallel()
 		t.Run("happy path", func(t *testing.T) {
 			tmpDir := t.TempDir()
-			runner := subshell.BackendRunner{Dir: &tmpDir, Verbose: false, CommandsCounter: &gohacks.Counter{}}
+			runner := subshell.BackendRunner{Dir: &tmpDir, Output: nil, Verbose: false, CommandsCounter: &gohacks.Counter{}}
 			output, err := runner.Query("echo", "hello", "world  ")
 			must.NoError(t, err)
 			must.EqOp(t, "hello world  \n", output)
@@ -28,7 +30,7 @@ func TestBackendRunner(t *testing.T) {
 		t.Run("unknown executable", func(t *testing.T) {
 			t.Parallel()
 			tmpDir := t.TempDir()
-			runner := subshell.BackendRunner{Dir: &tmpDir, Verbose: false, CommandsCounter: &gohacks.Counter{}}
+			runner := subshell.BackendRunner{Dir: &tmpDir, Output: nil, Verbose: false, CommandsCounter: &gohacks.Counter{}}
 			err := runner.Run("zonk")
 			must.Error(t, err)
 			var execError *exec.Error
@@ -38,7 +40,7 @@ func TestBackendRunner(t *testing.T) {
 		t.Run("non-zero exit code", func(t *testing.T) {
 			t.Parallel()
 			tmpDir := t.TempDir()
-			runner := subshell.BackendRunner{Dir: &tmpDir, Verbose: false, CommandsCounter: &gohacks.Counter{}}
+			runner := subshell.BackendRunner{Dir: &tmpDir, Output: nil, Verbose: false, CommandsCounter: &gohacks.Counter{}}
 			err := runner.Run("bash", "-c", "echo hi && exit 2")
 			expectedError := `
 ----------------------------------------
@@ -55,11 +57,22 @@ OUTPUT END
 		})
 	})
 
+	t.Run("Output", func(t *testing.T) {
+		t.Parallel()
+		tmpDir := t.TempDir()
+		output := strings.Builder{}
+		runner := subshell.BackendRunner{Dir: &tmpDir, Output: &output, Verbose: true, CommandsCounter: &gohacks.Counter{}}
+		_, err := runner.Query("echo", "hello")
+		must.NoError(t, err)
+		have := stripansi.Strip(output.String())
+		must.EqOp(t, "\n(verbose) echo hello\nhello\n", have)
+	})
+
 	t.Run("QueryTrim", func(t *testing.T) {
 		t.Parallel()
 		t.Run("trims whitespace", func(t *testing.T) {
 			tmpDir := t.TempDir()
-			runner := subshell.BackendRunner{Dir: &tmpDir, Verbose: false, CommandsCounter: &gohacks.Counter{}}
+			runner := subshell.BackendRunner{Dir: &tmpDir, Output: nil, Verbose: false, CommandsCounter: &gohacks.Counter{}}
 			output, err := runner.QueryTrim("echo", "hello", "world  ")
 			must.NoError(t, err)
 			must.EqOp(t, "hello world", output)
@@ -69,7 +82,7 @@ OUTPUT END
 	t.Run("RunMany", func(t *testing.T) {
 		t.Parallel()
 		tmpDir := t.TempDir()
-		runner := subshell.BackendRunner{Dir: &tmpDir, Verbose: false, CommandsCounter: &gohacks.Counter{}}
+		runner := subshell.BackendRunner{Dir: &tmpDir, Output: nil, Verbose: false, CommandsCounter: &gohacks.Counter{}}
 		err := runner.RunMany([][]string{
 			{"mkdir", "tmp"},
 			{"touch", "tmp/first"},
@@ -10,15 +10,17 @@ import (
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/gohacks"
+	"github.com/git-town/git-town/v13/src/gohacks/cache"
 	"github.com/git-town/git-town/v13/src/messages"
 )
 
 // FrontendRunner executes frontend shell commands.
 type FrontendRunner struct {
-	CommandsCounter  *gohacks.Counter
-	GetCurrentBranch GetCurrentBranchFunc
-	OmitBranchNames  bool
-	PrintCommands    bool
+	CommandsCounter   *gohacks.Counter
+	CommitCountsCache *cache.CommitCounts // the commands that this runner runs can change commits, so it invalidates this cache
+	GetCurrentBranch  GetCurrentBranchFunc
+	OmitBranchNames   bool
+	PrintCommands     bool
 }
 
 type GetCurrentBranchFunc func() (gitdomain.LocalBranchName, error)
@@ -54,6 +56,7 @@ func PrintCommand(branch gitdomain.LocalBranchName, omitBranch bool, cmd string,
 // Run runs the given command in this ShellRunner's directory.
 func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
 	self.CommandsCounter.Register()
+	self.CommitCountsCache.Invalidate()
 	var branchName gitdomain.LocalBranchName
 	if !self.OmitBranchNames {
 		branchName, err = self.GetCurrentBranch()
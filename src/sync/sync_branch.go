@@ -10,12 +10,16 @@ import (
 // BranchProgram syncs the given branch.
 func BranchProgram(branch gitdomain.BranchInfo, args BranchProgramArgs) {
 	parentBranchInfo := args.BranchInfos.FindByLocalName(args.Config.Lineage.Parent(branch.LocalName))
-	parentOtherWorktree := parentBranchInfo != nil && parentBranchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
+	// the local parent branch is outdated if it is active in another worktree that Git Town doesn't sync
+	parentOtherWorktree := parentBranchInfo != nil && parentBranchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree && args.Worktrees.FindByBranch(parentBranchInfo.LocalName) == nil
 	switch {
 	case branch.SyncStatus == gitdomain.SyncStatusDeletedAtRemote:
 		syncDeletedBranchProgram(args.Program, branch, parentOtherWorktree, args)
 	case branch.SyncStatus == gitdomain.SyncStatusOtherWorktree:
-		// Git Town doesn't sync branches that are active in another worktree
+		if worktree := args.Worktrees.FindByBranch(branch.LocalName); worktree != nil {
+			otherWorktreeBranchProgram(branch, worktree.Dir, parentOtherWorktree, args)
+		}
+		// Git Town doesn't sync branches that are active in other worktrees it cannot use
 	default:
 		ExistingBranchProgram(args.Program, branch, parentOtherWorktree, args)
 	}
@@ -29,6 +33,7 @@ type BranchProgramArgs struct {
 	Program       *program.Program
 	PushBranch    bool
 	Remotes       gitdomain.Remotes
+	Worktrees     gitdomain.Worktrees // the other worktrees in which Git Town syncs the branches they have checked out
 }
 
 // ExistingBranchProgram provides the opcode to sync a particular branch.
@@ -41,13 +46,14 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 	list.Add(&opcodes.Checkout{Branch: branch.LocalName})
 	branchType := args.Config.BranchType(branch.LocalName)
 	switch branchType {
-	case configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypePrototypeBranch:
 		FeatureBranchProgram(featureBranchArgs{
 			branch:              branch,
 			offline:             args.Config.Offline,
 			parentOtherWorktree: parentOtherWorktree,
+			previousParentSHA:   previousParentSHA(branch.LocalName, args),
 			program:             list,
-			syncStrategy:        args.Config.SyncFeatureStrategy,
+			syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
 		})
 	case configdomain.BranchTypePerennialBranch, configdomain.BranchTypeMainBranch:
 		PerennialBranchProgram(branch, args)
@@ -56,8 +62,9 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 			branch:              branch,
 			offline:             args.Config.Offline,
 			parentOtherWorktree: parentOtherWorktree,
+			previousParentSHA:   previousParentSHA(branch.LocalName, args),
 			program:             list,
-			syncStrategy:        args.Config.SyncFeatureStrategy,
+			syncStrategy:        args.Config.SyncFeatureStrategyFor(branch.LocalName),
 		})
 	case configdomain.BranchTypeContributionBranch:
 		ContributionBranchProgram(args.Program, branch)
@@ -71,18 +78,25 @@ func ExistingBranchProgram(list *program.Program, branch gitdomain.BranchInfo, p
 		case isMainOrPerennialBranch:
 			list.Add(&opcodes.PushCurrentBranch{CurrentBranch: branch.LocalName})
 		default:
-			pushFeatureBranchProgram(list, branch.LocalName, args.Config.SyncFeatureStrategy)
+			pushFeatureBranchProgram(list, branch.LocalName, args.Config.SyncFeatureStrategyFor(branch.LocalName))
 		}
 	}
 }
 
+// otherWorktreeBranchProgram provides the opcodes to sync the given branch inside the other worktree that has it checked out.
+func otherWorktreeBranchProgram(branch gitdomain.BranchInfo, worktreeDir gitdomain.RepoRootDir, parentOtherWorktree bool, args BranchProgramArgs) {
+	args.Program.Add(&opcodes.EnterWorktree{Branch: branch.LocalName, Dir: worktreeDir})
+	ExistingBranchProgram(args.Program, branch, parentOtherWorktree, args)
+	args.Program.Add(&opcodes.LeaveWorktree{})
+}
+
 // pullParentBranchOfCurrentFeatureBranchOpcode adds the opcode to pull updates from the parent branch of the current feature branch into the current feature branch.
 func pullParentBranchOfCurrentFeatureBranchOpcode(args featureBranchArgs) {
 	switch args.syncStrategy {
-	case configdomain.SyncFeatureStrategyMerge:
-		args.program.Add(&opcodes.MergeParent{CurrentBranch: args.branch.LocalName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
+	case configdomain.SyncFeatureStrategyMerge, configdomain.SyncFeatureStrategyCompress:
+		mergeParentProgram(args)
 	case configdomain.SyncFeatureStrategyRebase:
-		args.program.Add(&opcodes.RebaseParent{CurrentBranch: args.branch.LocalName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
+		rebaseParentProgram(args)
 	}
 }
 
@@ -90,7 +104,7 @@ func pushFeatureBranchProgram(list *program.Program, branch gitdomain.LocalBranc
 	switch syncFeatureStrategy {
 	case configdomain.SyncFeatureStrategyMerge:
 		list.Add(&opcodes.PushCurrentBranch{CurrentBranch: branch})
-	case configdomain.SyncFeatureStrategyRebase:
+	case configdomain.SyncFeatureStrategyRebase, configdomain.SyncFeatureStrategyCompress:
 		list.Add(&opcodes.ForcePushCurrentBranch{})
 	}
 }
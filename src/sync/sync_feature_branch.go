@@ -10,6 +10,8 @@ import (
 // FeatureBranchProgram adds the opcodes to sync the feature branch with the given name.
 func FeatureBranchProgram(args featureBranchArgs) {
 	switch args.syncStrategy {
+	case configdomain.SyncFeatureStrategyCompress:
+		syncFeatureBranchCompressProgram(args)
 	case configdomain.SyncFeatureStrategyMerge:
 		syncFeatureBranchMergeProgram(args)
 	case configdomain.SyncFeatureStrategyRebase:
@@ -21,26 +23,78 @@ type featureBranchArgs struct {
 	branch              gitdomain.BranchInfo             // the branch to sync
 	offline             configdomain.Offline             // whether offline mode is enabled
 	parentOtherWorktree bool                             // whether the parent of this branch exists on another worktre
+	previousParentSHA   gitdomain.SHA                    // the last SHA of the previous parent branch, if the commits of a shipped or deleted ancestor branch need to be removed from this branch
 	program             *program.Program                 // the program to update
 	syncStrategy        configdomain.SyncFeatureStrategy // the sync-feature-strategy
 }
 
-// syncs the given feature branch using the "merge" sync strategy
+// syncs the given feature branch using the "compress" sync strategy
+func syncFeatureBranchCompressProgram(args featureBranchArgs) {
+	syncFeatureBranchMergeProgram(args)
+	args.program.Add(&opcodes.CompressCurrentBranch{CurrentBranch: args.branch.LocalName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
+}
+
+// syncs the given feature branch using the "merge" sync strategy.
+// Merging doesn't rewrite the history of the branch, so it cannot remove the commits of shipped or deleted ancestor branches.
+// This strategy therefore ignores the previous parent SHA and only removes it from the configuration.
 func syncFeatureBranchMergeProgram(args featureBranchArgs) {
 	if args.branch.HasTrackingBranch() {
 		args.program.Add(&opcodes.Merge{Branch: args.branch.RemoteName.BranchName()})
 	}
-	args.program.Add(&opcodes.MergeParent{CurrentBranch: args.branch.LocalName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
+	mergeParentProgram(args)
 }
 
 // syncs the given feature branch using the "rebase" sync strategy
 func syncFeatureBranchRebaseProgram(args featureBranchArgs) {
-	// rebase against parent
-	args.program.Add(&opcodes.RebaseParent{
-		CurrentBranch:               args.branch.LocalName,
-		ParentActiveInOtherWorktree: args.parentOtherWorktree,
-	})
+	rebaseParentProgram(args)
 	if args.branch.HasTrackingBranch() && !args.offline.Bool() {
 		args.program.Add(&opcodes.RebaseFeatureTrackingBranch{RemoteBranch: args.branch.RemoteName})
 	}
 }
+
+// mergeParentProgram adds the opcode to merge the parent branch into the given feature branch.
+func mergeParentProgram(args featureBranchArgs) {
+	args.program.Add(&opcodes.MergeParent{CurrentBranch: args.branch.LocalName, ParentActiveInOtherWorktree: args.parentOtherWorktree})
+	if !args.previousParentSHA.IsEmpty() {
+		args.program.Add(&opcodes.RemovePreviousParentSHA{Branch: args.branch.LocalName})
+	}
+}
+
+// previousParentSHA provides the last SHA of the previous parent of the given branch
+// if the parent or another ancestor branch was shipped or deleted at origin.
+// This is the SHA that ship or an earlier sync recorded when removing the previous parent,
+// or the SHA of the parent before this sync if this sync removes an ancestor that was deleted at origin.
+// The commits of these ancestors are still in the given branch and need to be removed from it.
+func previousParentSHA(branch gitdomain.LocalBranchName, args BranchProgramArgs) gitdomain.SHA {
+	if sha, has := args.Config.PreviousParentSHAs[branch]; has {
+		return sha
+	}
+	parent := args.BranchInfos.FindByLocalName(args.Config.Lineage.Parent(branch))
+	if parent == nil || parent.LocalSHA.IsEmpty() {
+		return gitdomain.EmptySHA()
+	}
+	for _, ancestor := range args.Config.Lineage.Ancestors(branch) {
+		ancestorInfo := args.BranchInfos.FindByLocalName(ancestor)
+		if ancestorInfo != nil && ancestorInfo.SyncStatus == gitdomain.SyncStatusDeletedAtRemote {
+			return parent.LocalSHA
+		}
+	}
+	return gitdomain.EmptySHA()
+}
+
+// rebaseParentProgram adds the opcode to rebase the given feature branch against its parent branch.
+func rebaseParentProgram(args featureBranchArgs) {
+	if args.previousParentSHA.IsEmpty() {
+		args.program.Add(&opcodes.RebaseParent{
+			CurrentBranch:               args.branch.LocalName,
+			ParentActiveInOtherWorktree: args.parentOtherWorktree,
+		})
+		return
+	}
+	args.program.Add(&opcodes.RebaseOntoNewParent{
+		CurrentBranch:               args.branch.LocalName,
+		ParentActiveInOtherWorktree: args.parentOtherWorktree,
+		PreviousParentSHA:           args.previousParentSHA,
+	})
+	args.program.Add(&opcodes.RemovePreviousParentSHA{Branch: args.branch.LocalName})
+}
@@ -0,0 +1,52 @@
+package undo
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/subshell"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+)
+
+// ensureCleanWorktrees verifies that the other worktrees that the given undo program runs in contain no uncommitted changes.
+// Undoing the changes to the branches checked out in them resets these worktrees, which would destroy uncommitted changes.
+func ensureCleanWorktrees(undoProgram program.Program, runner *git.ProdRunner, verbose bool) error {
+	branches := gitdomain.LocalBranchNames{}
+	for _, opcode := range undoProgram {
+		if enterWorktree, isEnterWorktree := opcode.(*opcodes.EnterWorktree); isEnterWorktree {
+			branches = append(branches, enterWorktree.Branch)
+		}
+	}
+	if len(branches) == 0 {
+		return nil
+	}
+	worktrees, err := runner.Backend.Worktrees()
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		worktree := worktrees.FindByBranch(branch)
+		if worktree == nil {
+			continue
+		}
+		dir := worktree.Dir.String()
+		worktreeBackend := runner.Backend
+		worktreeBackend.Runner = subshell.BackendRunner{
+			CommandsCounter: runner.CommandsCounter,
+			Dir:             &dir,
+			Output:          nil,
+			Verbose:         verbose,
+		}
+		repoStatus, err := worktreeBackend.RepoStatus()
+		if err != nil {
+			return err
+		}
+		if repoStatus.OpenChanges {
+			return fmt.Errorf(messages.UndoWorktreeOpenChanges, branch, worktree.Dir)
+		}
+	}
+	return nil
+}
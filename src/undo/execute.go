@@ -7,6 +7,7 @@ import (
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v13/src/messages"
 	lightInterpreter "github.com/git-town/git-town/v13/src/vm/interpreter/light"
 	"github.com/git-town/git-town/v13/src/vm/runstate"
@@ -14,9 +15,10 @@ import (
 )
 
 // undoes the persisted runstate
+// and removes it from the undo history once it is undone.
 func Execute(args ExecuteArgs) error {
 	if args.RunState.DryRun {
-		return nil
+		return removeNewestHistoryEntry(args.RootDir)
 	}
 	program := CreateUndoForFinishedProgram(CreateUndoProgramArgs{
 		DryRun:         args.Runner.Config.DryRun,
@@ -25,16 +27,21 @@ func Execute(args ExecuteArgs) error {
 		Run:            args.Runner,
 		RunState:       args.RunState,
 	})
-	lightInterpreter.Execute(program, args.Runner, args.Lineage)
-	err := statefile.Delete(args.RootDir)
+	err := ensureCleanWorktrees(program, args.Runner, args.Verbose)
 	if err != nil {
-		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+		return err
+	}
+	lightInterpreter.Execute(program, args.Runner, args.Connector, args.Lineage, args.RootDir)
+	err = removeNewestHistoryEntry(args.RootDir)
+	if err != nil {
+		return err
 	}
 	print.Footer(args.Verbose, args.Runner.CommandsCounter.Count(), args.Runner.FinalMessages.Result())
 	return nil
 }
 
 type ExecuteArgs struct {
+	Connector        hostingdomain.Connector
 	FullConfig       *configdomain.FullConfig
 	HasOpenChanges   bool
 	InitialStashSize gitdomain.StashSize
@@ -44,3 +51,11 @@ type ExecuteArgs struct {
 	Runner           *git.ProdRunner
 	Verbose          bool
 }
+
+func removeNewestHistoryEntry(rootDir gitdomain.RepoRootDir) error {
+	err := statefile.RemoveNewestHistoryEntry(rootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package undo
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
+	"github.com/git-town/git-town/v13/src/vm/program"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+)
+
+// provides the program that changes the target branches of the given proposals back to what they were.
+// This program must run before the undo program for the branches
+// because the latter might delete the remote branches that the proposals currently target.
+func determineUndoProposalTargetsProgram(targets []runstate.UndoableProposalTarget) program.Program {
+	result := program.Program{}
+	for t := len(targets) - 1; t >= 0; t-- {
+		result.Add(&opcodes.UpdateProposalTarget{
+			NewTarget:      targets[t].OldTarget,
+			OldTarget:      gitdomain.EmptyLocalBranchName(),
+			ProposalNumber: targets[t].ProposalNumber,
+		})
+	}
+	return result
+}
@@ -78,18 +78,20 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	for _, branch := range omniChangedPerennials.BranchNames() {
 		change := omniChangedPerennials[branch]
 		if slice.Contains(args.UndoablePerennialCommits, change.After) {
-			result.Add(&opcodes.Checkout{Branch: branch})
+			enterBranch(&result, branch, args)
 			result.Add(&opcodes.RevertCommit{SHA: change.After})
 			result.Add(&opcodes.PushCurrentBranch{CurrentBranch: branch})
+			leaveBranch(&result, branch, args)
 		}
 	}
 
 	// reset omni-changed feature branches
 	for _, branch := range omniChangedFeatures.BranchNames() {
 		change := omniChangedFeatures[branch]
-		result.Add(&opcodes.Checkout{Branch: branch})
+		enterBranch(&result, branch, args)
 		result.Add(&opcodes.ResetCurrentBranchToSHA{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
 		result.Add(&opcodes.ForcePushCurrentBranch{})
+		leaveBranch(&result, branch, args)
 	}
 
 	// re-create removed omni-branches
@@ -105,16 +107,17 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	for _, inconsistentlyChangedPerennial := range inconsistentlyChangedPerennials {
 		if inconsistentlyChangedPerennial.After.IsOmniBranch() {
 			if slice.Contains(args.UndoablePerennialCommits, inconsistentlyChangedPerennial.After.LocalSHA) {
-				result.Add(&opcodes.Checkout{Branch: inconsistentlyChangedPerennial.Before.LocalName})
+				enterBranch(&result, inconsistentlyChangedPerennial.Before.LocalName, args)
 				result.Add(&opcodes.RevertCommit{SHA: inconsistentlyChangedPerennial.After.LocalSHA})
 				result.Add(&opcodes.PushCurrentBranch{CurrentBranch: inconsistentlyChangedPerennial.After.LocalName})
+				leaveBranch(&result, inconsistentlyChangedPerennial.Before.LocalName, args)
 			}
 		}
 	}
 
 	// reset inconsintently changed feature branches
 	for _, inconsistentChange := range inconsistentChangedFeatures {
-		result.Add(&opcodes.Checkout{Branch: inconsistentChange.Before.LocalName})
+		enterBranch(&result, inconsistentChange.Before.LocalName, args)
 		result.Add(&opcodes.ResetCurrentBranchToSHA{
 			MustHaveSHA: inconsistentChange.After.LocalSHA,
 			SetToSHA:    inconsistentChange.Before.LocalSHA,
@@ -125,6 +128,7 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 			MustHaveSHA: inconsistentChange.After.RemoteSHA,
 			SetToSHA:    inconsistentChange.Before.RemoteSHA,
 		})
+		leaveBranch(&result, inconsistentChange.Before.LocalName, args)
 	}
 
 	// remove remotely added branches
@@ -149,8 +153,9 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset locally changed branches
 	for _, localBranch := range self.LocalChanged.BranchNames() {
 		change := self.LocalChanged[localBranch]
-		result.Add(&opcodes.Checkout{Branch: localBranch})
+		enterBranch(&result, localBranch, args)
 		result.Add(&opcodes.ResetCurrentBranchToSHA{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
+		leaveBranch(&result, localBranch, args)
 	}
 
 	// re-create locally removed branches
@@ -167,6 +172,9 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		if args.EndBranch == addedLocalBranch {
 			result.Add(&opcodes.Checkout{Branch: args.BeginBranch})
 		}
+		if args.OtherWorktreeBranches.Contains(addedLocalBranch) {
+			result.Add(&opcodes.RemoveWorktree{Branch: addedLocalBranch})
+		}
 		result.Add(&opcodes.DeleteLocalBranch{Branch: addedLocalBranch})
 	}
 
@@ -194,5 +202,22 @@ type BranchChangesUndoProgramArgs struct {
 	BeginBranch              gitdomain.LocalBranchName
 	Config                   *configdomain.FullConfig
 	EndBranch                gitdomain.LocalBranchName
+	OtherWorktreeBranches    gitdomain.LocalBranchNames // branches that are active in other worktrees and get undone there
 	UndoablePerennialCommits []gitdomain.SHA
 }
+
+// enterBranch adds the opcodes to make the given branch the current branch to the given program.
+func enterBranch(result *program.Program, branch gitdomain.LocalBranchName, args BranchChangesUndoProgramArgs) {
+	if args.OtherWorktreeBranches.Contains(branch) {
+		result.Add(&opcodes.EnterWorktree{Branch: branch, Dir: gitdomain.EmptyRepoRootDir()})
+	} else {
+		result.Add(&opcodes.Checkout{Branch: branch})
+	}
+}
+
+// leaveBranch adds the opcodes to finish working on the given branch to the given program.
+func leaveBranch(result *program.Program, branch gitdomain.LocalBranchName, args BranchChangesUndoProgramArgs) {
+	if args.OtherWorktreeBranches.Contains(branch) {
+		result.Add(&opcodes.LeaveWorktree{})
+	}
+}
@@ -79,6 +79,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -89,6 +90,60 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("local-only branch added in another worktree", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{},
+			Active:   gitdomain.NewLocalBranchName("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("branch-1"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		haveChanges := undobranches.NewBranchSpans(before, after).Changes()
+		wantChanges := undobranches.BranchChanges{
+			LocalAdded:            gitdomain.NewLocalBranchNames("branch-1"),
+			LocalRemoved:          undobranches.LocalBranchesSHAs{},
+			LocalChanged:          undobranches.LocalBranchChange{},
+			RemoteAdded:           gitdomain.RemoteBranchNames{},
+			RemoteRemoved:         undobranches.RemoteBranchesSHAs{},
+			RemoteChanged:         map[gitdomain.RemoteBranchName]undodomain.Change[gitdomain.SHA]{},
+			OmniRemoved:           undobranches.LocalBranchesSHAs{},
+			OmniChanged:           undobranches.LocalBranchChange{},
+			InconsistentlyChanged: undodomain.InconsistentChanges{},
+		}
+		must.Eq(t, wantChanges, haveChanges)
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			Lineage: configdomain.Lineage{
+				gitdomain.NewLocalBranchName("branch-1"): gitdomain.NewLocalBranchName("main"),
+			},
+			PushHook:          false,
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames(),
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch:              before.Active,
+			Config:                   &config,
+			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.NewLocalBranchNames("branch-1"),
+			UndoablePerennialCommits: []gitdomain.SHA{},
+		})
+		wantProgram := program.Program{
+			&opcodes.RemoveWorktree{Branch: gitdomain.NewLocalBranchName("branch-1")},
+			&opcodes.DeleteLocalBranch{Branch: gitdomain.NewLocalBranchName("branch-1")},
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("main")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("local-only branch removed", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -133,6 +188,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -222,6 +278,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -312,6 +369,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -396,6 +454,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -464,6 +523,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -557,6 +617,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -653,6 +714,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -759,9 +821,10 @@ func TestChanges(t *testing.T) {
 			PushHook:          false,
 		}
 		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
-			BeginBranch: before.Active,
-			Config:      &config,
-			EndBranch:   after.Active,
+			BeginBranch:           before.Active,
+			Config:                &config,
+			EndBranch:             after.Active,
+			OtherWorktreeBranches: gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{
 				gitdomain.NewSHA("444444"),
 			},
@@ -781,6 +844,74 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("omnibranch in another worktree changed locally and remotely to same SHA", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("feature-branch"),
+					LocalSHA:   gitdomain.NewSHA("222222"),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/feature-branch"),
+					RemoteSHA:  gitdomain.NewSHA("222222"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA("111111"),
+					SyncStatus: gitdomain.SyncStatusUpToDate,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/main"),
+					RemoteSHA:  gitdomain.NewSHA("111111"),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("feature-branch"),
+					LocalSHA:   gitdomain.NewSHA("333333"),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: gitdomain.NewRemoteBranchName("origin/feature-branch"),
+					RemoteSHA:  gitdomain.NewSHA("333333"),
+				},
+			},
+			Active: gitdomain.NewLocalBranchName("main"),
+		}
+		haveChanges := undobranches.NewBranchSpans(before, after).Changes()
+		config := configdomain.FullConfig{ //nolint:exhaustruct
+			Lineage: configdomain.Lineage{
+				gitdomain.NewLocalBranchName("feature-branch"): gitdomain.NewLocalBranchName("main"),
+			},
+			MainBranch:        gitdomain.NewLocalBranchName("main"),
+			PerennialBranches: gitdomain.NewLocalBranchNames(),
+			PushHook:          false,
+		}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch:              before.Active,
+			Config:                   &config,
+			EndBranch:                after.Active,
+			OtherWorktreeBranches:    after.Branches.OtherWorktreeBranches(),
+			UndoablePerennialCommits: []gitdomain.SHA{},
+		})
+		wantProgram := program.Program{
+			// reset the feature branch to the previous SHA inside its worktree
+			&opcodes.EnterWorktree{Branch: gitdomain.NewLocalBranchName("feature-branch"), Dir: gitdomain.EmptyRepoRootDir()},
+			&opcodes.ResetCurrentBranchToSHA{MustHaveSHA: gitdomain.NewSHA("333333"), SetToSHA: gitdomain.NewSHA("222222"), Hard: true},
+			&opcodes.ForcePushCurrentBranch{},
+			&opcodes.LeaveWorktree{},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("main")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("upstream commit downloaded and branch shipped at the same time", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -859,9 +990,10 @@ func TestChanges(t *testing.T) {
 			PushHook:          false,
 		}
 		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
-			BeginBranch: before.Active,
-			Config:      &config,
-			EndBranch:   after.Active,
+			BeginBranch:           before.Active,
+			Config:                &config,
+			EndBranch:             after.Active,
+			OtherWorktreeBranches: gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{
 				gitdomain.NewSHA("444444"),
 			},
@@ -980,6 +1112,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -1076,6 +1209,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -1172,6 +1306,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -1256,6 +1391,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -1342,6 +1478,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -1423,6 +1560,7 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active,
 			Config:                   &config,
 			EndBranch:                after.Active,
+			OtherWorktreeBranches:    gitdomain.LocalBranchNames{},
 			UndoablePerennialCommits: []gitdomain.SHA{},
 		})
 		wantProgram := program.Program{
@@ -13,6 +13,7 @@ func DetermineUndoBranchesProgram(beginBranchesSnapshot, endBranchesSnapshot git
 		BeginBranch:              beginBranchesSnapshot.Active,
 		Config:                   fullConfig,
 		EndBranch:                endBranchesSnapshot.Active,
+		OtherWorktreeBranches:    endBranchesSnapshot.Branches.OtherWorktreeBranches(),
 		UndoablePerennialCommits: undoablePerennialCommits,
 	})
 }
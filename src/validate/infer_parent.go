@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"slices"
+
+	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+)
+
+// InferParent provides the most likely parent of the given branch among the given candidates,
+// or an empty branch name if it cannot determine one.
+//
+// The most reliable source is the target branch of an open proposal for the given branch.
+// Otherwise the parent is the candidate that the given branch diverged from most recently,
+// i.e. the candidate that is missing the fewest commits of the given branch.
+// Candidates that already contain all commits of the given branch are descendants of it and don't qualify.
+// Ties go to the candidate that has the fewest commits the given branch doesn't have,
+// and then to the candidate listed first.
+func InferParent(branch gitdomain.LocalBranchName, candidates gitdomain.LocalBranchNames, backend *git.BackendCommands, connector hostingdomain.Connector) (gitdomain.LocalBranchName, error) {
+	if connector != nil {
+		// proposals only improve the inference, failing to load them is not a reason to abort
+		proposal, err := connector.FindProposal(branch, gitdomain.EmptyLocalBranchName())
+		if err == nil && proposal != nil && slices.Contains(candidates, proposal.Target) {
+			return proposal.Target, nil
+		}
+	}
+	// Ahead: commits that the candidate has and the given branch doesn't, Behind: commits of the given branch that the candidate is missing
+	counts, err := backend.CommitCountsAgainst(branch, candidates)
+	if err != nil {
+		return gitdomain.EmptyLocalBranchName(), err
+	}
+	result := gitdomain.EmptyLocalBranchName()
+	var best *gitdomain.AheadBehind
+	for _, candidate := range candidates {
+		count, has := counts[candidate]
+		if !has {
+			continue
+		}
+		if count.Behind == 0 && count.Ahead > 0 {
+			continue
+		}
+		if best == nil || count.Behind < best.Behind || (count.Behind == best.Behind && count.Ahead < best.Ahead) {
+			result = candidate
+			best = &count
+		}
+	}
+	return result, nil
+}
+
+// ParentInferenceConnector provides the connector used to infer parent branches from proposals.
+// Creating a connector requires looking up the origin URL,
+// so implementations create it only when a parent branch actually needs to be inferred.
+type ParentInferenceConnector interface {
+	// Connector provides the connector to use, or nil if none is available.
+	Connector() hostingdomain.Connector
+}
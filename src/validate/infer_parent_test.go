@@ -0,0 +1,135 @@
+package validate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
+	"github.com/git-town/git-town/v13/src/validate"
+	testgit "github.com/git-town/git-town/v13/test/git"
+	"github.com/git-town/git-town/v13/test/testruntime"
+	"github.com/shoenig/test/must"
+)
+
+func TestInferParent(t *testing.T) {
+	t.Parallel()
+	initial := gitdomain.NewLocalBranchName("initial")
+	parent := gitdomain.NewLocalBranchName("parent")
+	branch := gitdomain.NewLocalBranchName("branch")
+
+	t.Run("excludes descendants of the branch", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		child := gitdomain.NewLocalBranchName("child")
+		runtime.CreateBranch(parent, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: parent, FileName: "parent_file", Message: "parent commit"})
+		runtime.CreateBranch(branch, parent)
+		runtime.CreateCommit(testgit.Commit{Branch: branch, FileName: "branch_file", Message: "branch commit"})
+		runtime.CreateBranch(child, branch)
+		runtime.CreateCommit(testgit.Commit{Branch: child, FileName: "child_file", Message: "child commit"})
+		candidates := gitdomain.LocalBranchNames{child, initial, parent}
+		have, err := validate.InferParent(branch, candidates, &runtime.Backend, nil)
+		must.NoError(t, err)
+		must.EqOp(t, parent, have)
+	})
+
+	t.Run("breaks ties by the fewest extra commits", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		sibling := gitdomain.NewLocalBranchName("sibling")
+		runtime.CreateBranch(branch, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: branch, FileName: "branch_file", Message: "branch commit"})
+		runtime.CreateBranch(sibling, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: sibling, FileName: "sibling_file", Message: "sibling commit"})
+		candidates := gitdomain.LocalBranchNames{sibling, initial}
+		have, err := validate.InferParent(branch, candidates, &runtime.Backend, nil)
+		must.NoError(t, err)
+		must.EqOp(t, initial, have)
+	})
+
+	t.Run("breaks full ties by the order of the candidates", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateBranch(parent, initial)
+		runtime.CreateBranch(branch, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: branch, FileName: "branch_file", Message: "branch commit"})
+		candidates := gitdomain.LocalBranchNames{parent, initial}
+		have, err := validate.InferParent(branch, candidates, &runtime.Backend, nil)
+		must.NoError(t, err)
+		must.EqOp(t, parent, have)
+	})
+
+	t.Run("prefers the target of the proposal for the branch", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateBranch(parent, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: parent, FileName: "parent_file", Message: "parent commit"})
+		runtime.CreateBranch(branch, parent)
+		runtime.CreateCommit(testgit.Commit{Branch: branch, FileName: "branch_file", Message: "branch commit"})
+		connector := fakeConnector{
+			proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+				branch: {Number: 1, Target: initial}, //nolint:exhaustruct
+			},
+		}
+		candidates := gitdomain.LocalBranchNames{initial, parent}
+		have, err := validate.InferParent(branch, candidates, &runtime.Backend, connector)
+		must.NoError(t, err)
+		must.EqOp(t, initial, have)
+	})
+
+	t.Run("ignores proposal targets that aren't candidates", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateBranch(parent, initial)
+		runtime.CreateCommit(testgit.Commit{Branch: parent, FileName: "parent_file", Message: "parent commit"})
+		runtime.CreateBranch(branch, parent)
+		runtime.CreateCommit(testgit.Commit{Branch: branch, FileName: "branch_file", Message: "branch commit"})
+		connector := fakeConnector{
+			proposals: map[gitdomain.LocalBranchName]*hostingdomain.Proposal{
+				branch: {Number: 1, Target: gitdomain.NewLocalBranchName("other")}, //nolint:exhaustruct
+			},
+		}
+		candidates := gitdomain.LocalBranchNames{initial, parent}
+		have, err := validate.InferParent(branch, candidates, &runtime.Backend, connector)
+		must.NoError(t, err)
+		must.EqOp(t, parent, have)
+	})
+}
+
+// fakeConnector is a hostingdomain.Connector stand-in that knows the given proposals.
+type fakeConnector struct {
+	proposals map[gitdomain.LocalBranchName]*hostingdomain.Proposal
+}
+
+func (self fakeConnector) CreateProposal(_, _ gitdomain.LocalBranchName, _, _ string, _ bool) (*hostingdomain.Proposal, error) {
+	return nil, errors.New("unexpected call")
+}
+
+func (self fakeConnector) DefaultProposalMessage(_ hostingdomain.Proposal) string {
+	return ""
+}
+
+func (self fakeConnector) FindProposal(branch, _ gitdomain.LocalBranchName) (*hostingdomain.Proposal, error) {
+	return self.proposals[branch], nil
+}
+
+func (self fakeConnector) NewProposalURL(_, _ gitdomain.LocalBranchName) (string, error) {
+	return "", nil
+}
+
+func (self fakeConnector) RepositoryURL() string {
+	return ""
+}
+
+func (self fakeConnector) SquashMergeProposal(_ int, _ gitdomain.CommitMessage) error {
+	return errors.New("unexpected call")
+}
+
+func (self fakeConnector) UpdateProposalBody(_ int, _ string) error {
+	return errors.New("unexpected call")
+}
+
+func (self fakeConnector) UpdateProposalTarget(_ int, _ gitdomain.LocalBranchName) error {
+	return errors.New("unexpected call")
+}
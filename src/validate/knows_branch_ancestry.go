@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/git-town/git-town/v13/src/cli/dialog"
@@ -8,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
 )
 
 // KnowsBranchAncestors prompts the user for all unknown ancestors of the given branch.
@@ -21,20 +23,34 @@ func KnowsBranchAncestors(branch gitdomain.LocalBranchName, args KnowsBranchAnce
 		lineage := args.Backend.Config.FullConfig.Lineage
 		parent, hasParent := lineage[currentBranch]
 		if !hasParent { //nolint:nestif
-			var aborted bool
 			var err error
-			parent, aborted, err = dialog.Parent(dialog.ParentArgs{
+			parentArgs := dialog.ParentArgs{
 				Branch:          currentBranch,
+				DefaultParent:   gitdomain.EmptyLocalBranchName(),
 				DialogTestInput: args.DialogTestInputs.Next(),
 				Lineage:         args.Config.Lineage,
 				LocalBranches:   args.LocalBranches,
 				MainBranch:      args.MainBranch,
-			})
-			if err != nil {
-				return false, err
 			}
-			if aborted {
-				os.Exit(0)
+			if args.InferParents {
+				candidates := dialog.ParentEntries(parentArgs)[1:] // all entries except the option to make the branch perennial
+				parentArgs.DefaultParent, err = InferParent(currentBranch, candidates, args.Backend, args.Connector.Connector())
+				if err != nil {
+					return false, err
+				}
+			}
+			if args.Yes && !parentArgs.DefaultParent.IsEmpty() {
+				parent = parentArgs.DefaultParent
+				fmt.Printf(messages.ParentInferred, currentBranch, parent)
+			} else {
+				var aborted bool
+				parent, aborted, err = dialog.Parent(parentArgs)
+				if err != nil {
+					return false, err
+				}
+				if aborted {
+					os.Exit(0)
+				}
 			}
 			if parent == dialog.PerennialBranchOption {
 				err = args.Backend.Config.AddToPerennialBranches(currentBranch)
@@ -61,9 +77,12 @@ func KnowsBranchAncestors(branch gitdomain.LocalBranchName, args KnowsBranchAnce
 type KnowsBranchAncestorsArgs struct {
 	Backend          *git.BackendCommands
 	Config           *configdomain.FullConfig
+	Connector        ParentInferenceConnector // used to infer parent branches from proposals
 	DialogTestInputs *components.TestInputs
+	InferParents     bool // whether to preselect the inferred parent branch in the dialog
 	LocalBranches    gitdomain.LocalBranchNames
 	MainBranch       gitdomain.LocalBranchName
+	Yes              bool // whether to apply inferred parent branches without asking the user
 }
 
 // KnowsBranchesAncestors asserts that the entire lineage for all given branches
@@ -76,9 +95,12 @@ func KnowsBranchesAncestors(args KnowsBranchesAncestorsArgs) (bool, error) {
 		branchUpdated, err := KnowsBranchAncestors(branch.LocalName, KnowsBranchAncestorsArgs{
 			Backend:          args.Backend,
 			Config:           args.Config,
+			Connector:        args.Connector,
 			DialogTestInputs: args.DialogTestInputs,
+			InferParents:     true,
 			LocalBranches:    args.LocalBranches.Names(),
 			MainBranch:       args.Config.MainBranch,
+			Yes:              args.Yes,
 		})
 		if err != nil {
 			return updated, err
@@ -93,6 +115,8 @@ func KnowsBranchesAncestors(args KnowsBranchesAncestorsArgs) (bool, error) {
 type KnowsBranchesAncestorsArgs struct {
 	Backend          *git.BackendCommands
 	Config           *configdomain.FullConfig
+	Connector        ParentInferenceConnector
 	DialogTestInputs *components.TestInputs
 	LocalBranches    gitdomain.BranchInfos
+	Yes              bool
 }
@@ -26,6 +26,6 @@ func autoUndo(opcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if err != nil {
 		return err
 	}
-	lightInterpreter.Execute(undoProgram, args.Run, args.Lineage)
+	lightInterpreter.Execute(undoProgram, args.Run, args.Connector, args.Lineage, args.RootDir)
 	return opcode.CreateAutomaticUndoError()
 }
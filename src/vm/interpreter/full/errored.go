@@ -6,15 +6,20 @@ import (
 
 	"github.com/git-town/git-town/v13/src/cli/print"
 	"github.com/git-town/git-town/v13/src/config/gitconfig"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
 	"github.com/git-town/git-town/v13/src/messages"
 	"github.com/git-town/git-town/v13/src/undo/undoconfig"
+	"github.com/git-town/git-town/v13/src/vm/opcodes"
 	"github.com/git-town/git-town/v13/src/vm/shared"
 	"github.com/git-town/git-town/v13/src/vm/statefile"
 )
 
 // errored is called when the given opcode has resulted in the given error.
 func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
-	var err error
+	worktreeBranch, worktreeDir, err := leaveWorktree(args)
+	if err != nil {
+		return err
+	}
 	args.RunState.EndBranchesSnapshot, err = args.Run.Backend.BranchesSnapshot()
 	if err != nil {
 		return err
@@ -36,11 +41,21 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if err != nil {
 		return err
 	}
-	args.RunState.AbortProgram.Add(failedOpcode.CreateAbortProgram()...)
+	if worktreeBranch.IsEmpty() {
+		args.RunState.AbortProgram.Add(failedOpcode.CreateAbortProgram()...)
+	} else {
+		// the failed opcode ran in another worktree, so aborting and continuing it must happen there as well
+		args.RunState.AbortProgram.Add(&opcodes.EnterWorktree{Branch: worktreeBranch, Dir: worktreeDir})
+		args.RunState.AbortProgram.Add(failedOpcode.CreateAbortProgram()...)
+		args.RunState.AbortProgram.Add(&opcodes.LeaveWorktree{})
+	}
 	if failedOpcode.ShouldAutomaticallyUndoOnError() {
 		return autoUndo(failedOpcode, runErr, args)
 	}
 	args.RunState.RunProgram.Prepend(failedOpcode.CreateContinueProgram()...)
+	if !worktreeBranch.IsEmpty() {
+		args.RunState.RunProgram.Prepend(&opcodes.EnterWorktree{Branch: worktreeBranch, Dir: worktreeDir})
+	}
 	err = args.RunState.MarkAsUnfinished(&args.Run.Backend)
 	if err != nil {
 		return err
@@ -53,7 +68,7 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if err != nil {
 		return err
 	}
-	if args.RunState.Command == "sync" && !(repoStatus.RebaseInProgress && args.Run.Config.FullConfig.IsMainBranch(currentBranch)) {
+	if args.RunState.Command == "sync" && worktreeBranch.IsEmpty() && !(repoStatus.RebaseInProgress && args.Run.Config.FullConfig.IsMainBranch(currentBranch)) {
 		args.RunState.UnfinishedDetails.CanSkip = true
 	}
 	err = statefile.Save(args.RunState, args.RootDir)
@@ -71,3 +86,21 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	message += "\n"
 	return errors.New(message)
 }
+
+// leaveWorktree returns to the worktree that Git Town was started in
+// if the failed opcode ran in another worktree.
+// It provides the branch checked out in that other worktree and its directory.
+func leaveWorktree(args ExecuteArgs) (gitdomain.LocalBranchName, gitdomain.RepoRootDir, error) {
+	worktree := args.RunState.ActiveWorktree
+	if worktree == nil {
+		return gitdomain.EmptyLocalBranchName(), gitdomain.EmptyRepoRootDir(), nil
+	}
+	err := args.Run.Frontend.NavigateToDir(args.RootDir)
+	if err != nil {
+		return worktree.Branch, worktree.Dir, err
+	}
+	args.Run.Backend.CurrentBranchCache.Invalidate()
+	// the continue and abort programs enter this worktree again
+	args.RunState.SetActiveWorktree(nil)
+	return worktree.Branch, worktree.Dir, nil
+}
@@ -30,7 +30,10 @@ func Execute(args ExecuteArgs) error {
 			Lineage:                         args.Lineage,
 			PrependOpcodes:                  args.RunState.RunProgram.Prepend,
 			RegisterUndoablePerennialCommit: args.RunState.RegisterUndoablePerennialCommit,
+			RegisterUndoableProposalTarget:  args.RunState.RegisterUndoableProposalTarget,
+			RootDir:                         args.RootDir,
 			Runner:                          args.Run,
+			SetActiveWorktree:               args.RunState.SetActiveWorktree,
 			UpdateInitialBranchLocalSHA:     args.InitialBranchesSnapshot.Branches.UpdateLocalSHA,
 		})
 		if err != nil {
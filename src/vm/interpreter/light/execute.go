@@ -6,19 +6,24 @@ import (
 	"github.com/git-town/git-town/v13/src/cli/dialog/components"
 	"github.com/git-town/git-town/v13/src/config/configdomain"
 	"github.com/git-town/git-town/v13/src/git"
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/hostingdomain"
 	"github.com/git-town/git-town/v13/src/vm/program"
 	"github.com/git-town/git-town/v13/src/vm/shared"
 )
 
-func Execute(prog program.Program, runner *git.ProdRunner, lineage configdomain.Lineage) {
+func Execute(prog program.Program, runner *git.ProdRunner, connector hostingdomain.Connector, lineage configdomain.Lineage, rootDir gitdomain.RepoRootDir) {
 	for _, opcode := range prog {
 		err := opcode.Run(shared.RunArgs{
-			Connector:                       nil,
+			Connector:                       connector,
 			DialogTestInputs:                nil,
 			Lineage:                         lineage,
 			PrependOpcodes:                  nil,
 			RegisterUndoablePerennialCommit: nil,
+			RegisterUndoableProposalTarget:  func(int, gitdomain.LocalBranchName) {},
+			RootDir:                         rootDir,
 			Runner:                          runner,
+			SetActiveWorktree:               func(*gitdomain.Worktree) {},
 			UpdateInitialBranchLocalSHA:     nil,
 		})
 		if err != nil {
@@ -0,0 +1,54 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/gohacks/slice"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// CompressCurrentBranch squashes the commits of the given branch into a single commit
+// on top of the branch that at runtime is the parent branch of the given branch.
+// The new commit uses the message of the first commit of the branch.
+type CompressCurrentBranch struct {
+	CurrentBranch               gitdomain.LocalBranchName
+	ParentActiveInOtherWorktree bool
+	undeclaredOpcodeMethods
+}
+
+func (self *CompressCurrentBranch) Run(args shared.RunArgs) error {
+	parent := args.Lineage.Parent(self.CurrentBranch)
+	if parent.IsEmpty() {
+		return nil
+	}
+	var parentToCompressOnto gitdomain.BranchName
+	if self.ParentActiveInOtherWorktree {
+		parentToCompressOnto = parent.TrackingBranch().BranchName()
+	} else {
+		parentToCompressOnto = parent.BranchName()
+	}
+	ahead, behind, err := args.Runner.Backend.CommitCounts(self.CurrentBranch.BranchName(), parentToCompressOnto)
+	if err != nil {
+		return err
+	}
+	if ahead < 2 && behind == 0 {
+		// the branch is already a single commit on top of its parent
+		return nil
+	}
+	hasChanges, err := args.Runner.Backend.BranchHasUnmergedChanges(self.CurrentBranch, parent)
+	if err != nil || !hasChanges {
+		return err
+	}
+	commits, err := args.Runner.Backend.CommitsInFeatureBranch(self.CurrentBranch, parent)
+	if err != nil {
+		return err
+	}
+	message := slice.FirstNonEmpty("", commits.Messages()...)
+	if message == "" {
+		return nil
+	}
+	err = args.Runner.Frontend.RemoveCommitsInCurrentBranch(parentToCompressOnto)
+	if err != nil {
+		return err
+	}
+	return args.Runner.Frontend.Commit(message, "")
+}
@@ -54,6 +54,7 @@ func Types() []shared.Opcode {
 		&CheckoutParent{},
 		&ChangeParent{},
 		&CommitOpenChanges{},
+		&CompressCurrentBranch{},
 		&ConnectorMergeProposal{},
 		&ContinueMerge{},
 		&ContinueRebase{},
@@ -62,15 +63,18 @@ func Types() []shared.Opcode {
 		&CreateProposal{},
 		&CreateRemoteBranch{},
 		&CreateTrackingBranch{},
+		&CreateWorktree{},
 		&DeleteLocalBranch{},
 		&DeleteParentBranch{},
 		&DeleteTrackingBranch{},
 		&DiscardOpenChanges{},
 		&EndOfBranchProgram{},
+		&EnterWorktree{},
 		&EnsureHasShippableChanges{},
 		&FetchUpstream{},
 		&ForcePushCurrentBranch{},
 		&DeleteBranchIfEmptyAtRuntime{},
+		&LeaveWorktree{},
 		&Merge{},
 		&MergeParent{},
 		&PreserveCheckoutHistory{},
@@ -79,11 +83,16 @@ func Types() []shared.Opcode {
 		&PushTags{},
 		&RebaseBranch{},
 		&RebaseFeatureTrackingBranch{},
+		&RebaseOnto{},
+		&RebaseOntoNewParent{},
 		&RebaseParent{},
 		&RemoveBranchFromLineage{},
 		&RemoveFromPerennialBranches{},
+		&RemoveFromPrototypeBranches{},
 		&RemoveGlobalConfig{},
 		&RemoveLocalConfig{},
+		&RemovePreviousParentSHA{},
+		&RemoveWorktree{},
 		&ResetCurrentBranchToSHA{},
 		&ResetRemoteBranchToSHA{},
 		&RestoreOpenChanges{},
@@ -93,10 +102,12 @@ func Types() []shared.Opcode {
 		&SetLocalConfig{},
 		&SetParent{},
 		&SetParentIfBranchExists{},
+		&SetPreviousParentSHA{},
 		&SkipCurrentBranch{},
 		&StashOpenChanges{},
 		&SquashMerge{},
 		&UndoLastCommit{},
+		&UpdateProposalStacks{},
 		&UpdateProposalTarget{},
 	}
 }
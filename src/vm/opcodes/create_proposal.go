@@ -1,14 +1,24 @@
 package opcodes
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v13/src/browser"
 	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/git-town/git-town/v13/src/messages"
 	"github.com/git-town/git-town/v13/src/vm/shared"
 )
 
 // CreateProposal creates a new proposal for the current branch.
+// If a title is given, it creates the proposal via the API of the hosting platform.
+// Otherwise it opens the page to create a new proposal in the browser.
 type CreateProposal struct {
-	Branch gitdomain.LocalBranchName
+	Body      string
+	Branch    gitdomain.LocalBranchName
+	Draft     bool
+	NoBrowser bool
+	Title     string
 	undeclaredOpcodeMethods
 }
 
@@ -20,10 +30,48 @@ func (self *CreateProposal) CreateContinueProgram() []shared.Opcode {
 
 func (self *CreateProposal) Run(args shared.RunArgs) error {
 	parentBranch := args.Runner.Config.FullConfig.Lineage[self.Branch]
-	prURL, err := args.Connector.NewProposalURL(self.Branch, parentBranch)
+	if self.Title == "" {
+		prURL, err := args.Connector.NewProposalURL(self.Branch, parentBranch)
+		if err != nil {
+			return err
+		}
+		browser.Open(prURL, args.Runner.Frontend.Runner, args.Runner.Backend.Runner)
+		return nil
+	}
+	if args.Runner.Config.DryRun {
+		return nil
+	}
+	config := args.Runner.Config.FullConfig
+	body := self.Body
+	isStacked := proposalstack.IsStacked(self.Branch, config.Lineage)
+	if isStacked {
+		// the proposal numbers get filled in once the proposal exists
+		section, err := proposalstack.Render(config.ProposalStackTemplate, proposalstack.Entries(self.Branch, config.Lineage, nil))
+		if err != nil {
+			return err
+		}
+		body = proposalstack.UpdateBody(body, section)
+	}
+	proposal, err := args.Connector.CreateProposal(self.Branch, parentBranch, self.Title, body, self.Draft)
 	if err != nil {
 		return err
 	}
-	browser.Open(prURL, args.Runner.Frontend.Runner, args.Runner.Backend.Runner)
+	args.Runner.FinalMessages.Add(fmt.Sprintf(messages.ProposalCreated, proposal.URL))
+	if isStacked {
+		err = proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+			AddSections:       false,
+			Branches:          gitdomain.LocalBranchNames{self.Branch},
+			Connector:         args.Connector,
+			Lineage:           config.Lineage,
+			OnlyGivenBranches: false,
+			Template:          config.ProposalStackTemplate,
+		})
+		if err != nil {
+			args.Runner.FinalMessages.Add(fmt.Sprintf(messages.ProposalStackUpdateProblem, err))
+		}
+	}
+	if !self.NoBrowser {
+		browser.Open(proposal.URL, args.Runner.Frontend.Runner, args.Runner.Backend.Runner)
+	}
 	return nil
 }
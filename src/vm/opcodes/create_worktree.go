@@ -0,0 +1,19 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// CreateWorktree creates a new branch off the given starting point
+// and checks it out in a new worktree at the given directory.
+type CreateWorktree struct {
+	Branch        gitdomain.LocalBranchName
+	Dir           string // relative to the root directory of the repo
+	StartingPoint gitdomain.Location
+	undeclaredOpcodeMethods
+}
+
+func (self *CreateWorktree) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.CreateWorktree(self.Dir, self.Branch, self.StartingPoint)
+}
@@ -10,7 +10,8 @@ import (
 
 // DeleteBranchIfEmptyAtRuntime allows running different opcodes based on a condition evaluated at runtime.
 type DeleteBranchIfEmptyAtRuntime struct {
-	Branch gitdomain.LocalBranchName
+	Branch     gitdomain.LocalBranchName
+	InitialSHA gitdomain.SHA // the SHA of the branch before Git Town synced it, which the child branches of the branch still contain
 	undeclaredOpcodeMethods
 }
 
@@ -24,16 +25,24 @@ func (self *DeleteBranchIfEmptyAtRuntime) Run(args shared.RunArgs) error {
 		args.PrependOpcodes(&QueueMessage{
 			Message: fmt.Sprintf(messages.BranchDeletedHasUnmergedChanges, self.Branch),
 		})
-	} else {
-		args.PrependOpcodes(
-			&CheckoutParent{CurrentBranch: self.Branch},
-			&DeleteLocalBranch{Branch: self.Branch},
-			&RemoveBranchFromLineage{
-				Branch: self.Branch,
-			},
-			&QueueMessage{
-				Message: fmt.Sprintf(messages.BranchDeleted, self.Branch),
-			})
+		return nil
 	}
+	opcodes := []shared.Opcode{
+		&CheckoutParent{CurrentBranch: self.Branch},
+		&DeleteLocalBranch{Branch: self.Branch},
+	}
+	if !self.InitialSHA.IsEmpty() {
+		for _, child := range args.Lineage.Children(self.Branch) {
+			opcodes = append(opcodes, &SetPreviousParentSHA{Branch: child, SHA: self.InitialSHA})
+		}
+	}
+	opcodes = append(opcodes,
+		&RemoveBranchFromLineage{
+			Branch: self.Branch,
+		},
+		&QueueMessage{
+			Message: fmt.Sprintf(messages.BranchDeleted, self.Branch),
+		})
+	args.PrependOpcodes(opcodes...)
 	return nil
 }
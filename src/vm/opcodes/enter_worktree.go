@@ -0,0 +1,38 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// EnterWorktree makes Git Town run the subsequent opcodes in the worktree that has the given branch checked out.
+type EnterWorktree struct {
+	Branch gitdomain.LocalBranchName
+	Dir    gitdomain.RepoRootDir // the directory of the worktree, determined at runtime if empty
+	undeclaredOpcodeMethods
+}
+
+func (self *EnterWorktree) Run(args shared.RunArgs) error {
+	dir := self.Dir
+	if dir.IsEmpty() {
+		worktrees, err := args.Runner.Backend.Worktrees()
+		if err != nil {
+			return err
+		}
+		worktree := worktrees.FindByBranch(self.Branch)
+		if worktree == nil {
+			return fmt.Errorf(messages.WorktreeNotFound, self.Branch)
+		}
+		dir = worktree.Dir
+	}
+	err := args.Runner.Frontend.NavigateToDir(dir)
+	if err != nil {
+		return err
+	}
+	args.Runner.Backend.CurrentBranchCache.Set(self.Branch)
+	args.SetActiveWorktree(&gitdomain.Worktree{Branch: self.Branch, Dir: dir})
+	return nil
+}
@@ -0,0 +1,19 @@
+package opcodes
+
+import "github.com/git-town/git-town/v13/src/vm/shared"
+
+// LeaveWorktree makes Git Town run the subsequent opcodes in the worktree it was started in again.
+type LeaveWorktree struct {
+	undeclaredOpcodeMethods
+}
+
+func (self *LeaveWorktree) Run(args shared.RunArgs) error {
+	err := args.Runner.Frontend.NavigateToDir(args.RootDir)
+	if err != nil {
+		return err
+	}
+	// the branch checked out in this worktree might have changed since Git Town left it
+	args.Runner.Backend.CurrentBranchCache.Invalidate()
+	args.SetActiveWorktree(nil)
+	return nil
+}
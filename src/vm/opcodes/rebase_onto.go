@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// RebaseOnto rebases the current branch onto the given branch,
+// dropping the commits that the current branch shares with the given upstream location.
+type RebaseOnto struct {
+	BranchToRebaseOnto gitdomain.BranchName
+	CommitsToRemove    gitdomain.Location
+	undeclaredOpcodeMethods
+}
+
+func (self *RebaseOnto) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortRebase{},
+	}
+}
+
+func (self *RebaseOnto) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseOnto) Run(args shared.RunArgs) error {
+	return args.Runner.Frontend.RebaseOnto(self.BranchToRebaseOnto, self.CommitsToRemove)
+}
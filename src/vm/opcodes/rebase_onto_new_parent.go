@@ -0,0 +1,42 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// RebaseOntoNewParent rebases the given branch against the branch that is its parent at runtime,
+// dropping all commits that the given branch shares with the given last SHA of its previous parent.
+// This removes the commits of parent branches that were squash-merged or deleted from the given branch.
+type RebaseOntoNewParent struct {
+	CurrentBranch               gitdomain.LocalBranchName
+	ParentActiveInOtherWorktree bool
+	PreviousParentSHA           gitdomain.SHA
+	undeclaredOpcodeMethods
+}
+
+func (self *RebaseOntoNewParent) CreateAbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&AbortRebase{},
+	}
+}
+
+func (self *RebaseOntoNewParent) CreateContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ContinueRebase{},
+	}
+}
+
+func (self *RebaseOntoNewParent) Run(args shared.RunArgs) error {
+	parent := args.Lineage.Parent(self.CurrentBranch)
+	if parent.IsEmpty() {
+		return nil
+	}
+	var branchToRebaseOnto gitdomain.BranchName
+	if self.ParentActiveInOtherWorktree {
+		branchToRebaseOnto = parent.TrackingBranch().BranchName()
+	} else {
+		branchToRebaseOnto = parent.BranchName()
+	}
+	return args.Runner.Frontend.RebaseOnto(branchToRebaseOnto, self.PreviousParentSHA.Location())
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// RemoveFromPrototypeBranches removes the branch with the given name as a prototype branch.
+type RemoveFromPrototypeBranches struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RemoveFromPrototypeBranches) Run(args shared.RunArgs) error {
+	return args.Runner.Config.RemoveFromPrototypeBranches(self.Branch)
+}
@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// RemovePreviousParentSHA removes the recorded SHA of the previous parent of the given branch
+// once the given branch no longer contains the commits of its previous parent.
+type RemovePreviousParentSHA struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RemovePreviousParentSHA) Run(args shared.RunArgs) error {
+	args.Runner.Config.RemovePreviousParentSHA(self.Branch)
+	return nil
+}
@@ -0,0 +1,33 @@
+package opcodes
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// RemoveWorktree removes the worktree that has the given branch checked out.
+type RemoveWorktree struct {
+	Branch gitdomain.LocalBranchName
+	undeclaredOpcodeMethods
+}
+
+func (self *RemoveWorktree) Run(args shared.RunArgs) error {
+	worktrees, err := args.Runner.Backend.Worktrees()
+	if err != nil {
+		return err
+	}
+	worktree := worktrees.FindByBranch(self.Branch)
+	if worktree == nil {
+		return fmt.Errorf(messages.WorktreeNotFound, self.Branch)
+	}
+	// refer to the worktree relative to the root directory of the repo, the way the user created it
+	dir, err := filepath.Rel(args.RootDir.String(), worktree.Dir.String())
+	if err != nil {
+		return err
+	}
+	return args.Runner.Frontend.RemoveWorktree(gitdomain.NewRepoRootDir(dir))
+}
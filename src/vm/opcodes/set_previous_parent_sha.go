@@ -0,0 +1,19 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// SetPreviousParentSHA records the last SHA of the parent of the given branch
+// before Git Town ships or deletes that parent.
+// The next sync of the given branch removes the commits of the previous parent from it.
+type SetPreviousParentSHA struct {
+	Branch gitdomain.LocalBranchName
+	SHA    gitdomain.SHA
+	undeclaredOpcodeMethods
+}
+
+func (self *SetPreviousParentSHA) Run(args shared.RunArgs) error {
+	return args.Runner.Config.SetPreviousParentSHA(self.Branch, self.SHA)
+}
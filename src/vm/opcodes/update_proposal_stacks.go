@@ -0,0 +1,53 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/hosting/proposalstack"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/vm/shared"
+)
+
+// UpdateProposalStacks refreshes the stack sections in the proposals
+// of the given branches that the running command has changed.
+// Problems talking to the hosting platform don't abort the running command.
+type UpdateProposalStacks struct {
+	Branches gitdomain.BranchInfos // the branches to update, as they were before the running command changed them
+	undeclaredOpcodeMethods
+}
+
+func (self *UpdateProposalStacks) Run(args shared.RunArgs) error {
+	if args.Connector == nil || args.Runner.Config.DryRun {
+		return nil
+	}
+	branchesSnapshot, err := args.Runner.Backend.BranchesSnapshot()
+	if err != nil {
+		return err
+	}
+	changedBranches := gitdomain.LocalBranchNames{}
+	for _, before := range self.Branches {
+		after := branchesSnapshot.Branches.FindByLocalName(before.LocalName)
+		if after == nil {
+			continue
+		}
+		if after.LocalSHA != before.LocalSHA || after.RemoteSHA != before.RemoteSHA {
+			changedBranches = append(changedBranches, before.LocalName)
+		}
+	}
+	if len(changedBranches) == 0 {
+		return nil
+	}
+	err = proposalstack.UpdateStacks(proposalstack.UpdateStacksArgs{
+		AddSections:       false,
+		Branches:          changedBranches,
+		Connector:         args.Connector,
+		Lineage:           args.Runner.Config.FullConfig.Lineage,
+		OnlyGivenBranches: true,
+		Template:          args.Runner.Config.FullConfig.ProposalStackTemplate,
+	})
+	if err != nil {
+		args.Runner.FinalMessages.Add(fmt.Sprintf(messages.ProposalStackUpdateProblem, err))
+	}
+	return nil
+}
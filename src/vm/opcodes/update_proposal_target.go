@@ -11,6 +11,7 @@ import (
 // UpdateProposalTarget updates the target of the proposal with the given number at the code hosting platform.
 type UpdateProposalTarget struct {
 	NewTarget      gitdomain.LocalBranchName
+	OldTarget      gitdomain.LocalBranchName // the current target of the proposal, used to undo this change, empty if this change should not be undone
 	ProposalNumber int
 	undeclaredOpcodeMethods
 }
@@ -20,7 +21,17 @@ func (self *UpdateProposalTarget) CreateAutomaticUndoError() error {
 }
 
 func (self *UpdateProposalTarget) Run(args shared.RunArgs) error {
-	return args.Connector.UpdateProposalTarget(self.ProposalNumber, self.NewTarget)
+	if args.Connector == nil {
+		return fmt.Errorf(messages.ProposalTargetNoConnector, self.ProposalNumber, self.NewTarget)
+	}
+	err := args.Connector.UpdateProposalTarget(self.ProposalNumber, self.NewTarget)
+	if err != nil {
+		return err
+	}
+	if !self.OldTarget.IsEmpty() {
+		args.RegisterUndoableProposalTarget(self.ProposalNumber, self.OldTarget)
+	}
+	return nil
 }
 
 func (self *UpdateProposalTarget) ShouldAutomaticallyUndoOnError() bool {
@@ -0,0 +1,44 @@
+package runstate
+
+import (
+	"time"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+)
+
+// HistoryLength defines how many previously executed Git Town commands the undo history remembers.
+const HistoryLength = 20
+
+// History contains the run states of previously executed Git Town commands, most recent first.
+type History []HistoryEntry
+
+// Add registers the given entry as the most recent one,
+// forgetting the oldest entries beyond HistoryLength.
+func (self *History) Add(entry HistoryEntry) {
+	result := append(History{entry}, *self...)
+	if len(result) > HistoryLength {
+		result = result[:HistoryLength]
+	}
+	*self = result
+}
+
+// UndoableCount provides how many of the most recent entries in this history can be undone one after the other.
+// Undoing an older entry is only safe if the branches didn't change between that command and the next more recent one.
+func (self History) UndoableCount() int {
+	expected := gitdomain.EmptyBranchesSnapshot() // the branches that the next older command must have ended with
+	for e, entry := range self {
+		if !expected.IsEmpty() && !entry.RunState.EndBranchesSnapshot.IsEmpty() && !entry.RunState.EndBranchesSnapshot.HasSameBranches(expected) {
+			return e
+		}
+		if !entry.RunState.BeginBranchesSnapshot.IsEmpty() {
+			expected = entry.RunState.BeginBranchesSnapshot
+		}
+	}
+	return len(self)
+}
+
+// HistoryEntry is the run state of a Git Town command that ran in the past.
+type HistoryEntry struct {
+	EndTime  time.Time // when the command ended
+	RunState RunState
+}
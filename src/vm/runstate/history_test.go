@@ -0,0 +1,95 @@
+package runstate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	snapshot := func(sha string) gitdomain.BranchesSnapshot {
+		return gitdomain.BranchesSnapshot{
+			Active: gitdomain.NewLocalBranchName("main"),
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  gitdomain.NewLocalBranchName("main"),
+					LocalSHA:   gitdomain.NewSHA(sha),
+					RemoteName: gitdomain.EmptyRemoteBranchName(),
+					RemoteSHA:  gitdomain.EmptySHA(),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+				},
+			},
+		}
+	}
+	entry := func(command string, begin, end gitdomain.BranchesSnapshot) runstate.HistoryEntry {
+		runState := runstate.EmptyRunState()
+		runState.Command = command
+		runState.BeginBranchesSnapshot = begin
+		runState.EndBranchesSnapshot = end
+		return runstate.HistoryEntry{
+			EndTime:  time.Time{},
+			RunState: runState,
+		}
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		t.Run("adds the entry as the most recent one", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{entry("sync", snapshot("111111"), snapshot("222222"))}
+			history.Add(entry("ship", snapshot("222222"), snapshot("333333")))
+			must.Len(t, 2, history)
+			must.EqOp(t, "ship", history[0].RunState.Command)
+			must.EqOp(t, "sync", history[1].RunState.Command)
+		})
+		t.Run("forgets the oldest entries", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			for i := 0; i <= runstate.HistoryLength; i++ {
+				history.Add(entry("sync", snapshot("111111"), snapshot("111111")))
+			}
+			history.Add(entry("ship", snapshot("111111"), snapshot("111111")))
+			must.Len(t, runstate.HistoryLength, history)
+			must.EqOp(t, "ship", history[0].RunState.Command)
+		})
+	})
+
+	t.Run("UndoableCount", func(t *testing.T) {
+		t.Parallel()
+		t.Run("empty history", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{}
+			must.EqOp(t, 0, history.UndoableCount())
+		})
+		t.Run("consecutive commands", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{
+				entry("ship", snapshot("222222"), snapshot("333333")),
+				entry("sync", snapshot("111111"), snapshot("222222")),
+			}
+			must.EqOp(t, 2, history.UndoableCount())
+		})
+		t.Run("branches changed between commands", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{
+				entry("ship", snapshot("333333"), snapshot("444444")),
+				entry("sync", snapshot("111111"), snapshot("222222")),
+			}
+			must.EqOp(t, 1, history.UndoableCount())
+		})
+		t.Run("commands that only change configuration", func(t *testing.T) {
+			t.Parallel()
+			history := runstate.History{
+				entry("ship", snapshot("222222"), snapshot("333333")),
+				entry("offline", gitdomain.EmptyBranchesSnapshot(), gitdomain.EmptyBranchesSnapshot()),
+				entry("sync", snapshot("111111"), snapshot("222222")),
+			}
+			must.EqOp(t, 3, history.UndoableCount())
+		})
+	})
+}
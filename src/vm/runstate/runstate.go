@@ -17,7 +17,8 @@ import (
 // including which operations are left to do,
 // and how to undo what has been done so far.
 type RunState struct {
-	AbortProgram             program.Program `exhaustruct:"optional"`
+	AbortProgram             program.Program     `exhaustruct:"optional"`
+	ActiveWorktree           *gitdomain.Worktree `exhaustruct:"optional"` // the other worktree that the opcodes currently run in, nil if they run in the worktree that Git Town was started in
 	BeginBranchesSnapshot    gitdomain.BranchesSnapshot
 	BeginConfigSnapshot      undoconfig.ConfigSnapshot
 	BeginStashSize           gitdomain.StashSize
@@ -30,6 +31,7 @@ type RunState struct {
 	IsUndo                   bool            `exhaustruct:"optional"` // TODO: remove?
 	RunProgram               program.Program
 	UndoablePerennialCommits []gitdomain.SHA            `exhaustruct:"optional"`
+	UndoableProposalTargets  []UndoableProposalTarget   `exhaustruct:"optional"`
 	UnfinishedDetails        *UnfinishedRunStateDetails `exhaustruct:"optional"`
 }
 
@@ -96,6 +98,22 @@ func (self *RunState) RegisterUndoablePerennialCommit(commit gitdomain.SHA) {
 	self.UndoablePerennialCommits = append(self.UndoablePerennialCommits, commit)
 }
 
+// RegisterUndoableProposalTarget stores the target branch that the proposal with the given number had
+// before this Git Town command changed it.
+// This method is used as a callback.
+func (self *RunState) RegisterUndoableProposalTarget(proposalNumber int, oldTarget gitdomain.LocalBranchName) {
+	self.UndoableProposalTargets = append(self.UndoableProposalTargets, UndoableProposalTarget{
+		OldTarget:      oldTarget,
+		ProposalNumber: proposalNumber,
+	})
+}
+
+// SetActiveWorktree records the other worktree that the subsequent opcodes run in.
+// Nil means the worktree that Git Town was started in.
+func (self *RunState) SetActiveWorktree(worktree *gitdomain.Worktree) {
+	self.ActiveWorktree = worktree
+}
+
 // SkipCurrentBranchProgram removes the opcodes for the current branch
 // from this run state.
 func (self *RunState) SkipCurrentBranchProgram() {
@@ -59,6 +59,7 @@ func TestRunState(t *testing.T) {
 			BeginConfigSnapshot:      undoconfig.EmptyConfigSnapshot(),
 			BeginStashSize:           0,
 			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoableProposalTargets:  []runstate.UndoableProposalTarget{},
 		}
 		encoded, err := json.MarshalIndent(runState, "", "  ")
 		must.NoError(t, err)
@@ -74,6 +75,7 @@ func TestRunState(t *testing.T) {
       "type": "ResetCurrentBranchToSHA"
     }
   ],
+  "ActiveWorktree": null,
   "BeginBranchesSnapshot": {
     "Active": "",
     "Branches": []
@@ -122,6 +124,7 @@ func TestRunState(t *testing.T) {
     }
   ],
   "UndoablePerennialCommits": [],
+  "UndoableProposalTargets": [],
   "UnfinishedDetails": null
 }`[1:]
 		must.EqOp(t, want, string(encoded))
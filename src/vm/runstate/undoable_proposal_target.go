@@ -0,0 +1,9 @@
+package runstate
+
+import "github.com/git-town/git-town/v13/src/git/gitdomain"
+
+// UndoableProposalTarget describes a proposal whose target branch a Git Town command has changed.
+type UndoableProposalTarget struct {
+	OldTarget      gitdomain.LocalBranchName // the target branch of the proposal before the change
+	ProposalNumber int
+}
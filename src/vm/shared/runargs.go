@@ -14,6 +14,9 @@ type RunArgs struct {
 	Lineage                         configdomain.Lineage
 	PrependOpcodes                  func(...Opcode)
 	RegisterUndoablePerennialCommit func(gitdomain.SHA)
+	RegisterUndoableProposalTarget  func(int, gitdomain.LocalBranchName)
+	RootDir                         gitdomain.RepoRootDir
 	Runner                          *git.ProdRunner
+	SetActiveWorktree               func(*gitdomain.Worktree)
 	UpdateInitialBranchLocalSHA     func(gitdomain.LocalBranchName, gitdomain.SHA) error
 }
@@ -0,0 +1,136 @@
+package statefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/messages"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+)
+
+// HistoryFilePath provides the path of the file that stores the undo history for the given Git repo.
+func HistoryFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(messages.RunstatePathProblem, err)
+	}
+	historyDir := filepath.Join(configDir, "git-town", "runstate", "history")
+	filename := SanitizePath(repoDir)
+	return filepath.Join(historyDir, filename+".json"), nil
+}
+
+// LoadHistory provides the run states of all Git Town commands for the given Git repo that "git town undo" can undo,
+// most recent first.
+// This includes the currently stored run state.
+func LoadHistory(repoDir gitdomain.RepoRootDir) (runstate.History, error) {
+	result, err := loadArchive(repoDir)
+	if err != nil {
+		return result, err
+	}
+	current, err := loadEntry(repoDir)
+	if err != nil {
+		return result, err
+	}
+	if current != nil {
+		result = append(runstate.History{*current}, result...)
+	}
+	return result, nil
+}
+
+// RemoveNewestHistoryEntry removes the most recent entry of the undo history for the given Git repo.
+func RemoveNewestHistoryEntry(repoDir gitdomain.RepoRootDir) error {
+	current, err := Load(repoDir)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		return Delete(repoDir)
+	}
+	archive, err := loadArchive(repoDir)
+	if err != nil || len(archive) == 0 {
+		return err
+	}
+	return saveArchive(archive[1:], repoDir)
+}
+
+// archiveCurrent moves the currently stored run state into the undo history if it belongs to a finished command.
+func archiveCurrent(repoDir gitdomain.RepoRootDir) error {
+	current, err := loadEntry(repoDir)
+	if err != nil {
+		return err
+	}
+	if current == nil || !current.RunState.IsFinished() || current.RunState.DryRun || current.RunState.IsUndo {
+		return nil
+	}
+	archive, err := loadArchive(repoDir)
+	if err != nil {
+		return err
+	}
+	archive.Add(*current)
+	return saveArchive(archive, repoDir)
+}
+
+// loadArchive loads the run states of the commands that ran before the one whose run state is currently stored.
+func loadArchive(repoDir gitdomain.RepoRootDir) (runstate.History, error) {
+	filename, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return runstate.History{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return runstate.History{}, nil
+		}
+		return runstate.History{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var result runstate.History
+	err = json.Unmarshal(content, &result)
+	if err != nil {
+		return runstate.History{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return result, nil
+}
+
+// loadEntry provides the currently stored run state together with the time it was stored.
+// Can return nil if there is no saved runstate.
+func loadEntry(repoDir gitdomain.RepoRootDir) (*runstate.HistoryEntry, error) {
+	runState, err := Load(repoDir)
+	if err != nil || runState == nil {
+		return nil, err
+	}
+	filename, err := FilePath(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo, err := os.Stat(filename)
+	if err != nil {
+		return nil, fmt.Errorf(messages.FileStatProblem, filename, err)
+	}
+	return &runstate.HistoryEntry{
+		EndTime:  fileInfo.ModTime(),
+		RunState: *runState,
+	}, nil
+}
+
+func saveArchive(archive runstate.History, repoDir gitdomain.RepoRootDir) error {
+	content, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
+	filename, err := HistoryFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(filename), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filename, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, filename, err)
+	}
+	return nil
+}
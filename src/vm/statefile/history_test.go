@@ -0,0 +1,73 @@
+package statefile_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/git-town/git-town/v13/src/git/gitdomain"
+	"github.com/git-town/git-town/v13/src/vm/runstate"
+	"github.com/git-town/git-town/v13/src/vm/statefile"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Save, LoadHistory, RemoveNewestHistoryEntry", func(t *testing.T) {
+		t.Parallel()
+		repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-history")
+		runstatePath, err := statefile.FilePath(repoRoot)
+		must.NoError(t, err)
+		historyPath, err := statefile.HistoryFilePath(repoRoot)
+		must.NoError(t, err)
+		_ = os.Remove(runstatePath)
+		_ = os.Remove(historyPath)
+		syncState := runstate.EmptyRunState()
+		syncState.Command = "sync"
+		err = statefile.Save(&syncState, repoRoot)
+		must.NoError(t, err)
+		shipState := runstate.EmptyRunState()
+		shipState.Command = "ship"
+		err = statefile.Save(&shipState, repoRoot)
+		must.NoError(t, err)
+		history, err := statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 2, history)
+		must.EqOp(t, "ship", history[0].RunState.Command)
+		must.EqOp(t, "sync", history[1].RunState.Command)
+		err = statefile.RemoveNewestHistoryEntry(repoRoot)
+		must.NoError(t, err)
+		history, err = statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 1, history)
+		must.EqOp(t, "sync", history[0].RunState.Command)
+		err = statefile.RemoveNewestHistoryEntry(repoRoot)
+		must.NoError(t, err)
+		history, err = statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 0, history)
+	})
+
+	t.Run("unfinished run states don't go into the history", func(t *testing.T) {
+		t.Parallel()
+		repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests-history-unfinished")
+		runstatePath, err := statefile.FilePath(repoRoot)
+		must.NoError(t, err)
+		historyPath, err := statefile.HistoryFilePath(repoRoot)
+		must.NoError(t, err)
+		_ = os.Remove(runstatePath)
+		_ = os.Remove(historyPath)
+		unfinishedState := runstate.EmptyRunState()
+		unfinishedState.Command = "sync"
+		unfinishedState.UnfinishedDetails = &runstate.UnfinishedRunStateDetails{} //nolint:exhaustruct
+		err = statefile.Save(&unfinishedState, repoRoot)
+		must.NoError(t, err)
+		finishedState := runstate.EmptyRunState()
+		finishedState.Command = "sync"
+		err = statefile.Save(&finishedState, repoRoot)
+		must.NoError(t, err)
+		history, err := statefile.LoadHistory(repoRoot)
+		must.NoError(t, err)
+		must.Len(t, 1, history)
+	})
+}
@@ -12,7 +12,12 @@ import (
 )
 
 // Save stores the given run state for the given Git repo to disk.
+// The previously stored run state of a finished command moves into the undo history.
 func Save(runState *runstate.RunState, repoDir gitdomain.RepoRootDir) error {
+	err := archiveCurrent(repoDir)
+	if err != nil {
+		return err
+	}
 	content, err := json.MarshalIndent(runState, "", "  ")
 	if err != nil {
 		return fmt.Errorf(messages.RunstateSerializeProblem, err)
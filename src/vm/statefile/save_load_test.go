@@ -56,6 +56,10 @@ func TestLoadSave(t *testing.T) {
 				},
 				&opcodes.Checkout{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.CommitOpenChanges{},
+				&opcodes.CompressCurrentBranch{
+					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
+					ParentActiveInOtherWorktree: true,
+				},
 				&opcodes.ConnectorMergeProposal{
 					Branch:          gitdomain.NewLocalBranchName("branch"),
 					CommitMessage:   "commit message",
@@ -68,7 +72,13 @@ func TestLoadSave(t *testing.T) {
 					Branch:        gitdomain.NewLocalBranchName("branch"),
 					StartingPoint: gitdomain.NewSHA("123456").Location(),
 				},
-				&opcodes.CreateProposal{Branch: gitdomain.NewLocalBranchName("branch")},
+				&opcodes.CreateProposal{
+					Body:      "body",
+					Branch:    gitdomain.NewLocalBranchName("branch"),
+					Draft:     true,
+					NoBrowser: true,
+					Title:     "title",
+				},
 				&opcodes.CreateRemoteBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					SHA:    gitdomain.NewSHA("123456"),
@@ -76,6 +86,11 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.CreateTrackingBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
+				&opcodes.CreateWorktree{
+					Branch:        gitdomain.NewLocalBranchName("branch"),
+					Dir:           "../worktree",
+					StartingPoint: gitdomain.NewLocalBranchName("main").Location(),
+				},
 				&opcodes.DeleteLocalBranch{Branch: gitdomain.NewLocalBranchName("branch")},
 				&opcodes.DeleteParentBranch{
 					Branch: gitdomain.NewLocalBranchName("branch"),
@@ -85,6 +100,10 @@ func TestLoadSave(t *testing.T) {
 				},
 				&opcodes.DiscardOpenChanges{},
 				&opcodes.EndOfBranchProgram{},
+				&opcodes.EnterWorktree{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+					Dir:    gitdomain.NewRepoRootDir("/worktree"),
+				},
 				&opcodes.EnsureHasShippableChanges{
 					Branch: gitdomain.NewLocalBranchName("branch"),
 					Parent: gitdomain.NewLocalBranchName("parent"),
@@ -93,6 +112,7 @@ func TestLoadSave(t *testing.T) {
 					Branch: gitdomain.NewLocalBranchName("branch"),
 				},
 				&opcodes.ForcePushCurrentBranch{},
+				&opcodes.LeaveWorktree{},
 				&opcodes.Merge{Branch: gitdomain.NewBranchName("branch")},
 				&opcodes.MergeParent{
 					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
@@ -107,6 +127,15 @@ func TestLoadSave(t *testing.T) {
 				},
 				&opcodes.PushTags{},
 				&opcodes.RebaseBranch{Branch: gitdomain.NewBranchName("branch")},
+				&opcodes.RebaseOnto{
+					BranchToRebaseOnto: gitdomain.NewBranchName("branch"),
+					CommitsToRemove:    gitdomain.NewLocalBranchName("parent").Location(),
+				},
+				&opcodes.RebaseOntoNewParent{
+					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
+					ParentActiveInOtherWorktree: true,
+					PreviousParentSHA:           gitdomain.NewSHA("123456"),
+				},
 				&opcodes.RebaseParent{
 					CurrentBranch:               gitdomain.NewLocalBranchName("branch"),
 					ParentActiveInOtherWorktree: true,
@@ -123,6 +152,9 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.RemoveLocalConfig{
 					Key: gitconfig.KeyOffline,
 				},
+				&opcodes.RemoveWorktree{
+					Branch: gitdomain.NewLocalBranchName("branch"),
+				},
 				&opcodes.ResetCurrentBranchToSHA{
 					Hard:        true,
 					MustHaveSHA: gitdomain.NewSHA("222222"),
@@ -155,9 +187,21 @@ func TestLoadSave(t *testing.T) {
 					Parent:        gitdomain.NewLocalBranchName("parent"),
 				},
 				&opcodes.StashOpenChanges{},
+				&opcodes.UpdateProposalStacks{
+					Branches: gitdomain.BranchInfos{
+						{
+							LocalName:  gitdomain.NewLocalBranchName("branch"),
+							LocalSHA:   gitdomain.NewSHA("111111"),
+							RemoteName: gitdomain.NewRemoteBranchName("origin/branch"),
+							RemoteSHA:  gitdomain.NewSHA("222222"),
+							SyncStatus: gitdomain.SyncStatusNotInSync,
+						},
+					},
+				},
 				&opcodes.UpdateProposalTarget{
 					ProposalNumber: 123,
 					NewTarget:      gitdomain.NewLocalBranchName("new-target"),
+					OldTarget:      gitdomain.NewLocalBranchName("old-target"),
 				},
 			},
 			UnfinishedDetails: &runstate.UnfinishedRunStateDetails{
@@ -166,11 +210,13 @@ func TestLoadSave(t *testing.T) {
 				EndTime:   time.Time{},
 			},
 			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoableProposalTargets:  []runstate.UndoableProposalTarget{},
 		}
 
 		wantJSON := `
 {
   "AbortProgram": [],
+  "ActiveWorktree": null,
   "BeginBranchesSnapshot": {
     "Active": "",
     "Branches": []
@@ -225,6 +271,13 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "CommitOpenChanges"
     },
+    {
+      "data": {
+        "CurrentBranch": "branch",
+        "ParentActiveInOtherWorktree": true
+      },
+      "type": "CompressCurrentBranch"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -251,7 +304,11 @@ func TestLoadSave(t *testing.T) {
     },
     {
       "data": {
-        "Branch": "branch"
+        "Body": "body",
+        "Branch": "branch",
+        "Draft": true,
+        "NoBrowser": true,
+        "Title": "title"
       },
       "type": "CreateProposal"
     },
@@ -268,6 +325,14 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CreateTrackingBranch"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Dir": "../worktree",
+        "StartingPoint": "main"
+      },
+      "type": "CreateWorktree"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -294,6 +359,13 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "EndOfBranchProgram"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Dir": "/worktree"
+      },
+      "type": "EnterWorktree"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -311,6 +383,10 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "ForcePushCurrentBranch"
     },
+    {
+      "data": {},
+      "type": "LeaveWorktree"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -352,6 +428,21 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RebaseBranch"
     },
+    {
+      "data": {
+        "BranchToRebaseOnto": "branch",
+        "CommitsToRemove": "parent"
+      },
+      "type": "RebaseOnto"
+    },
+    {
+      "data": {
+        "CurrentBranch": "branch",
+        "ParentActiveInOtherWorktree": true,
+        "PreviousParentSHA": "123456"
+      },
+      "type": "RebaseOntoNewParent"
+    },
     {
       "data": {
         "CurrentBranch": "branch",
@@ -383,6 +474,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RemoveLocalConfig"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "RemoveWorktree"
+    },
     {
       "data": {
         "Hard": true,
@@ -445,15 +542,31 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "StashOpenChanges"
     },
+    {
+      "data": {
+        "Branches": [
+          {
+            "LocalName": "branch",
+            "LocalSHA": "111111",
+            "RemoteName": "origin/branch",
+            "RemoteSHA": "222222",
+            "SyncStatus": "not in sync"
+          }
+        ]
+      },
+      "type": "UpdateProposalStacks"
+    },
     {
       "data": {
         "NewTarget": "new-target",
+        "OldTarget": "old-target",
         "ProposalNumber": 123
       },
       "type": "UpdateProposalTarget"
     }
   ],
   "UndoablePerennialCommits": [],
+  "UndoableProposalTargets": [],
   "UnfinishedDetails": {
     "CanSkip": true,
     "EndBranch": "end-branch",
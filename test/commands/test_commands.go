@@ -189,6 +189,14 @@ func (self *TestCommands) CreatePerennialBranches(names ...gitdomain.LocalBranch
 	asserts.NoError(self.Config.AddToPerennialBranches(names...))
 }
 
+// CreatePrototypeBranches creates prototype branches with the given names in this repository.
+func (self *TestCommands) CreatePrototypeBranches(names ...gitdomain.LocalBranchName) {
+	for _, name := range names {
+		self.CreateFeatureBranch(name)
+	}
+	asserts.NoError(self.Config.AddToPrototypeBranches(names...))
+}
+
 // CreateStandaloneTag creates a tag not on a branch.
 func (self *TestCommands) CreateStandaloneTag(name string) {
 	self.MustRun("git", "checkout", "-b", "temp")
@@ -364,6 +372,12 @@ func (self *TestCommands) RebaseAgainstBranch(branch gitdomain.LocalBranchName)
 	return self.Run("git", "rebase", branch.String())
 }
 
+// SquashMergeBranch merges the given branch into the current branch as a single commit with the given message.
+func (self *TestCommands) SquashMergeBranch(branch gitdomain.LocalBranchName, message string) {
+	self.MustRun("git", "merge", "--squash", branch.String())
+	self.CommitStagedChanges(message)
+}
+
 // RemoveBranch deletes the branch with the given name from this repo.
 func (self *TestCommands) RemoveBranch(name gitdomain.LocalBranchName) {
 	self.MustRun("git", "branch", "-D", name.String())
@@ -393,9 +407,18 @@ func (self *TestCommands) RemoveUnnecessaryFiles() {
 	_ = os.Remove(filepath.Join(self.WorkingDir, ".git", "description"))
 }
 
+// FullSHAsForCommit provides the unabbreviated SHAs for the commit with the given name.
+func (self *TestCommands) FullSHAsForCommit(name string) gitdomain.SHAs {
+	return self.shasForCommit(name, "%H")
+}
+
 // SHAForCommit provides the SHA for the commit with the given name.
 func (self *TestCommands) SHAsForCommit(name string) gitdomain.SHAs {
-	output := self.MustQuery("git", "reflog", "--format=%h %s")
+	return self.shasForCommit(name, "%h")
+}
+
+func (self *TestCommands) shasForCommit(name, shaFormat string) gitdomain.SHAs {
+	output := self.MustQuery("git", "reflog", "--format="+shaFormat+" %s")
 	if output == "" {
 		panic(fmt.Sprintf("cannot find the SHA of commit %q", name))
 	}
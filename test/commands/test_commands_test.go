@@ -393,6 +393,21 @@ func TestTestCommands(t *testing.T) {
 		must.EqOp(t, 7, len(sha))
 	})
 
+	t.Run("FullSHAsForCommit", func(t *testing.T) {
+		t.Parallel()
+		repo := testruntime.Create(t)
+		repo.CreateCommit(git.Commit{
+			Branch:      gitdomain.NewLocalBranchName("initial"),
+			FileContent: "bar",
+			FileName:    "foo",
+			Message:     "commit",
+		})
+		shas := repo.FullSHAsForCommit("commit")
+		must.EqOp(t, 1, len(shas))
+		sha := shas.First()
+		must.EqOp(t, 40, len(sha))
+	})
+
 	t.Run("UncommittedFiles", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -153,6 +153,15 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^a rebase is now in progress in the other worktree$`, func() error {
+		repoStatus, err := state.fixture.SecondWorktree.RepoStatus()
+		asserts.NoError(err)
+		if !repoStatus.RebaseInProgress {
+			return errors.New("expected rebase in progress in the other worktree")
+		}
+		return nil
+	})
+
 	suite.Step(`^a remote feature branch "([^"]*)"$`, func(branchText string) error {
 		branch := gitdomain.NewLocalBranchName(branchText)
 		// we are creating a remote branch in the remote repo --> it is a local branch there
@@ -192,6 +201,11 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^an uncommitted file in the other worktree$`, func() error {
+		state.fixture.SecondWorktree.CreateFile("uncommitted file", "uncommitted content")
+		return nil
+	})
+
 	suite.Step(`^an uncommitted file with name "([^"]+)" and content "([^"]+)"$`, func(name, content string) error {
 		state.uncommittedFileName = name
 		state.uncommittedContent = content
@@ -253,6 +267,18 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is (?:now|still) a prototype branch`, func(name string) error {
+		branch := gitdomain.NewLocalBranchName(name)
+		if !state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf(
+				"branch %q isn't a prototype branch as expected.\nPrototype branches: %s",
+				branch,
+				strings.Join(state.fixture.DevRepo.Config.FullConfig.PrototypeBranches.Strings(), ", "),
+			)
+		}
+		return nil
+	})
+
 	suite.Step(`^branch "([^"]+)" is (?:now|still) perennial`, func(name string) error {
 		branch := gitdomain.NewLocalBranchName(name)
 		if !state.fixture.DevRepo.Config.FullConfig.IsPerennialBranch(branch) {
@@ -273,6 +299,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		if state.fixture.DevRepo.Config.FullConfig.IsObservedBranch(branch) {
 			return fmt.Errorf("branch %q is observed", branch)
 		}
+		if state.fixture.DevRepo.Config.FullConfig.IsPrototypeBranch(branch) {
+			return fmt.Errorf("branch %q is a prototype branch", branch)
+		}
 		if state.fixture.DevRepo.Config.FullConfig.IsContributionBranch(branch) {
 			return fmt.Errorf("branch %q is contribution", branch)
 		}
@@ -282,6 +311,33 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^branch "([^"]+)" is now checked out in the worktree at "([^"]+)"$`, func(name, dir string) error {
+		worktrees, err := state.fixture.DevRepo.Worktrees()
+		if err != nil {
+			return err
+		}
+		worktree := worktrees.FindByBranch(gitdomain.NewLocalBranchName(name))
+		if worktree == nil {
+			return fmt.Errorf("branch %q is not checked out in any worktree", name)
+		}
+		want := filepath.Join(state.fixture.DevRepo.WorkingDir, dir)
+		if worktree.Dir.String() != want {
+			return fmt.Errorf("expected branch %q to be checked out at %q but it is at %q", name, want, worktree.Dir)
+		}
+		return nil
+	})
+
+	suite.Step(`^branch "([^"]+)" is not checked out in any worktree$`, func(name string) error {
+		worktrees, err := state.fixture.DevRepo.Worktrees()
+		if err != nil {
+			return err
+		}
+		if worktree := worktrees.FindByBranch(gitdomain.NewLocalBranchName(name)); worktree != nil {
+			return fmt.Errorf("branch %q is still checked out in the worktree at %q", name, worktree.Dir)
+		}
+		return nil
+	})
+
 	suite.Step(`^display "([^"]+)"$`, func(command string) error {
 		parts := strings.Split(command, " ")
 		output, err := state.fixture.DevRepo.Runner.Query(parts[0], parts[1:]...)
@@ -335,6 +391,12 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(configKey, value)
 	})
 
+	suite.Step(`^Git Town sync-strategy setting for branch "([^"]*)" is "([^"]*)"$`, func(branch, value string) error {
+		branchName := gitdomain.NewLocalBranchName(branch)
+		configKey := gitconfig.NewSyncStrategyKey(branchName)
+		return state.fixture.DevRepo.Config.GitConfig.SetLocalConfigValue(configKey, value)
+	})
+
 	suite.Step(`^local Git setting "init.defaultbranch" is "([^"]*)"$`, func(value string) error {
 		state.fixture.DevRepo.SetDefaultGitBranch(gitdomain.NewLocalBranchName(value))
 		return nil
@@ -821,6 +883,33 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^local Git Town setting "azure-devops-token" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.AzureDevOpsToken
+		want := configdomain.AzureDevOpsToken(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "azure-devops-token" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-app-password" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketAppPassword
+		want := configdomain.BitbucketAppPassword(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-app-password" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
+	suite.Step(`^local Git Town setting "bitbucket-username" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.BitbucketUsername
+		want := configdomain.BitbucketUsername(wantStr)
+		if *have != want {
+			return fmt.Errorf(`expected local setting "bitbucket-username" to be %q, but was %q`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "gitea-token" is now "([^"]*)"$`, func(wantStr string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.GiteaToken
 		want := configdomain.GiteaToken(wantStr)
@@ -892,8 +981,8 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 
 	suite.Step(`^local Git Town setting "perennial-regex" is now "([^"]*)"$`, func(wantStr string) error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.PerennialRegex
-		want := configdomain.PerennialRegex(wantStr)
-		if *have != want {
+		want := configdomain.NewPerennialRegex(wantStr)
+		if have.String() != want.String() {
 			return fmt.Errorf(`expected local setting "perennial-regex" to be %q, but was %q`, want, have)
 		}
 		return nil
@@ -937,6 +1026,17 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return fmt.Errorf(`expected local setting "push-new-branches" to be %v, but was %v`, want, have)
 	})
 
+	suite.Step(`^local Git Town setting "share-lineage" is now "([^"]*)"$`, func(wantStr string) error {
+		have := state.fixture.DevRepo.Config.LocalGitConfig.ShareLineage
+		wantBool, err := strconv.ParseBool(wantStr)
+		asserts.NoError(err)
+		want := configdomain.ShareLineage(wantBool)
+		if have == nil || *have != want {
+			return fmt.Errorf(`expected local setting "share-lineage" to be %v, but was %v`, want, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^local Git Town setting "ship-delete-tracking-branch" is still not set$`, func() error {
 		have := state.fixture.DevRepo.Config.LocalGitConfig.ShipDeleteTrackingBranch
 		if have == nil {
@@ -1083,6 +1183,17 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^no rebase is in progress in the other worktree$`, func() error {
+		repoStatus, err := state.fixture.SecondWorktree.RepoStatus()
+		if err != nil {
+			return err
+		}
+		if repoStatus.RebaseInProgress {
+			return errors.New("expected no rebase in progress in the other worktree")
+		}
+		return nil
+	})
+
 	suite.Step(`^no tool to open browsers is installed$`, func() error {
 		state.fixture.DevRepo.MockNoCommandsInstalled()
 		return nil
@@ -1113,6 +1224,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^origin now has this shared lineage:$`, func(expected *messages.PickleStepArgument_PickleDocString) error {
+		have := state.fixture.OriginRepo.MustQuery("git", "cat-file", "blob", configdomain.SharedLineageRef)
+		if have != expected.Content {
+			return fmt.Errorf("expected shared lineage:\n%s\n\nbut have:\n%s", expected.Content, have)
+		}
+		return nil
+	})
+
 	suite.Step(`^origin ships the "([^"]*)" branch$`, func(branch string) error {
 		state.fixture.OriginRepo.CheckoutBranch(gitdomain.NewLocalBranchName("main"))
 		err := state.fixture.OriginRepo.MergeBranch(gitdomain.NewLocalBranchName(branch))
@@ -1123,6 +1242,13 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^origin ships the "([^"]*)" branch using a squash merge$`, func(branch string) error {
+		state.fixture.OriginRepo.CheckoutBranch(gitdomain.NewLocalBranchName("main"))
+		state.fixture.OriginRepo.SquashMergeBranch(gitdomain.NewLocalBranchName(branch), branch)
+		state.fixture.OriginRepo.RemoveBranch(gitdomain.NewLocalBranchName(branch))
+		return nil
+	})
+
 	suite.Step(`^the branches "([^"]+)" and "([^"]+)"$`, func(branch1, branch2 string) error {
 		for _, branchName := range []string{branch1, branch2} {
 			branch := gitdomain.NewLocalBranchName(branchName)
@@ -1267,7 +1393,7 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
-	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
+	suite.Step(`^the current branch is an? (local )?(feature|perennial|parked|prototype|contribution|observed) branch "([^"]*)"$`, func(localStr, branchType, branchName string) error {
 		branch := gitdomain.NewLocalBranchName(branchName)
 		isLocal := localStr != ""
 		switch branchType {
@@ -1279,6 +1405,9 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		case "parked":
 			state.fixture.DevRepo.CreateParkedBranches(branch)
 			state.initialLineage.AddRow(branchName, "main")
+		case "prototype":
+			state.fixture.DevRepo.CreatePrototypeBranches(branch)
+			state.initialLineage.AddRow(branchName, "main")
 		case "contribution":
 			state.fixture.DevRepo.CreateContributionBranches(branch)
 		case "observed":
@@ -1561,6 +1690,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^there are (?:now|still) no prototype branches$`, func() error {
+		branches := state.fixture.DevRepo.Config.LocalGitConfig.PrototypeBranches
+		if branches != nil && len(*branches) > 0 {
+			return fmt.Errorf("expected no prototype branches, got %q", branches)
+		}
+		return nil
+	})
+
 	suite.Step(`^there are (?:now|still) no perennial branches$`, func() error {
 		branches := state.fixture.DevRepo.Config.LocalGitConfig.PerennialBranches
 		if branches != nil && len(*branches) > 0 {
@@ -1628,6 +1765,14 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the uncommitted file still exists in the other worktree$`, func() error {
+		hasFile := state.fixture.SecondWorktree.HasFile("uncommitted file", "uncommitted content")
+		if hasFile != "" {
+			return errors.New(hasFile)
+		}
+		return nil
+	})
+
 	suite.Step(`^these branches exist now$`, func(input *messages.PickleStepArgument_PickleTable) error {
 		currentBranches := state.fixture.Branches()
 		// fmt.Printf("NOW:\n%s\n", currentBranches.String())
@@ -1651,6 +1796,11 @@ func Steps(suite *godog.Suite, state *ScenarioState) {
 		return nil
 	})
 
+	suite.Step(`^the hosting connector command "([^"]*)" responds with:$`, func(name string, response *messages.PickleStepArgument_PickleDocString) error {
+		state.fixture.DevRepo.MockHostingConnector(name, response.Content)
+		return state.fixture.DevRepo.Run("git", "config", "git-town.hosting-connector-command", name)
+	})
+
 	suite.Step(`^tool "([^"]*)" is broken$`, func(name string) error {
 		state.fixture.DevRepo.MockBrokenCommand(name)
 		return nil
@@ -82,6 +82,11 @@ func (self *DataTable) Expand(localRepo runner, remoteRepo runner, worktreeRepo
 					shas := localRepo.SHAsForCommit(commitName)
 					sha := shas.First()
 					cell = strings.Replace(cell, match, sha.String(), 1)
+				case strings.HasPrefix(match, "{{ full-sha "):
+					commitName := match[13 : len(match)-4]
+					shas := localRepo.FullSHAsForCommit(commitName)
+					sha := shas.First()
+					cell = strings.Replace(cell, match, sha.String(), 1)
 				case strings.HasPrefix(match, "{{ sha-in-origin "):
 					commitName := match[18 : len(match)-4]
 					shas := remoteRepo.SHAsForCommit(commitName)
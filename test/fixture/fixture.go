@@ -127,8 +127,10 @@ func (self *Fixture) AddSecondWorktree(branch gitdomain.LocalBranchName) {
 		Runner:             &runner,
 		DryRun:             false,
 		Config:             self.DevRepo.Config,
+		CommitCountsCache:  &cache.CommitCounts{},
 		CurrentBranchCache: &cache.LocalBranchWithPrevious{},
 		RemotesCache:       &cache.Remotes{},
+		VersionCache:       &cache.GitVersion{},
 	}
 	self.SecondWorktree = &testruntime.TestRuntime{
 		TestCommands: commands.TestCommands{
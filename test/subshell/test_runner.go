@@ -66,6 +66,12 @@ func (self *TestRunner) MockCommand(name string) {
 	self.createMockBinary(name, content)
 }
 
+// MockHostingConnector adds a hosting connector command with the given name that always prints the given response.
+func (self *TestRunner) MockHostingConnector(name, response string) {
+	content := fmt.Sprintf("#!/usr/bin/env bash\n\ncat > /dev/null\necho %q\n", response)
+	self.createMockBinary(name, content)
+}
+
 // MockCommitMessage sets up this runner with an editor that enters the given commit message.
 func (self *TestRunner) MockCommitMessage(message string) {
 	self.gitEditor = "git_editor"
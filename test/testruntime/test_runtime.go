@@ -91,8 +91,10 @@ func New(workingDir, homeDir, binDir string) TestRuntime {
 		Runner:             &runner,
 		DryRun:             false,
 		Config:             config,
+		CommitCountsCache:  &cache.CommitCounts{},
 		CurrentBranchCache: &cache.LocalBranchWithPrevious{},
 		RemotesCache:       &cache.Remotes{},
+		VersionCache:       &cache.GitVersion{},
 	}
 	testCommands := commands.TestCommands{
 		BackendCommands: &backendCommands,